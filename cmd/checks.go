@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/config"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+var checksOutputFormat string
+
+// checksCmd groups the subcommands about the available checks
+var checksCmd = &cobra.Command{
+	Use:   "checks",
+	Short: "Show information about the available checks",
+}
+
+// checksListCmd lists all checks
+var checksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all checks with severity, group and default enablement",
+	Long: `Lists every check with its severity, its group and whether it is enabled by the
+default_rules of the configuration in use. Directory overrides are not taken
+into account.`,
+	Args:         cobra.NoArgs,
+	RunE:         runChecksList,
+	SilenceUsage: true,
+}
+
+// listChecksCmd is a shortcut for "checks list"
+var listChecksCmd = &cobra.Command{
+	Use:          "list-checks",
+	Short:        "List all checks (same as \"checks list\")",
+	Args:         cobra.NoArgs,
+	RunE:         runChecksList,
+	SilenceUsage: true,
+}
+
+// explainCmd explains a single check
+var explainCmd = &cobra.Command{
+	Use:          "explain CHECK",
+	Short:        "Explain a check, with rationale and examples",
+	Args:         cobra.ExactArgs(1),
+	RunE:         runExplain,
+	SilenceUsage: true,
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{checksListCmd, listChecksCmd} {
+		cmd.Flags().StringVar(&checksOutputFormat, "output", "table", "Output format: 'table' or 'json'")
+	}
+	checksCmd.AddCommand(checksListCmd)
+	rootCmd.AddCommand(checksCmd)
+	rootCmd.AddCommand(listChecksCmd)
+	rootCmd.AddCommand(explainCmd)
+}
+
+// checkListEntry is a single check as listed by "checks list"
+type checkListEntry struct {
+	ID          string `json:"id"`
+	Severity    string `json:"severity"`
+	Group       string `json:"group"`
+	Enabled     bool   `json:"enabled"`
+	Description string `json:"description"`
+}
+
+func runChecksList(cmd *cobra.Command, args []string) error {
+	configManager, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	entries := listChecks(configManager.GetConfig().DefaultRules)
+
+	switch checksOutputFormat {
+	case "table":
+		return writeChecksTable(os.Stdout, entries)
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(entries)
+	default:
+		return fmt.Errorf("unknown output format %q", checksOutputFormat)
+	}
+}
+
+// listChecks returns all checks in logical order, marking the ones enabled by
+// the given rules
+func listChecks(rules config.RuleSet) []checkListEntry {
+	var entries []checkListEntry
+	for _, check := range validator.GetChecks() {
+		entries = append(entries, checkListEntry{
+			ID:          check.ID,
+			Severity:    check.Severity,
+			Group:       check.Group,
+			Enabled:     containsString(rules.EnabledChecks, check.ID) && !containsString(rules.DisabledChecks, check.ID),
+			Description: check.Description,
+		})
+	}
+	return entries
+}
+
+// writeChecksTable writes the checks as a human-readable table
+func writeChecksTable(w io.Writer, entries []checkListEntry) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHECK\tSEVERITY\tGROUP\tENABLED\tDESCRIPTION")
+	for _, entry := range entries {
+		enabled := "no"
+		if entry.Enabled {
+			enabled = "yes"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", entry.ID, entry.Severity, entry.Group, enabled, entry.Description)
+	}
+	return tw.Flush()
+}
+
+func runExplain(cmd *cobra.Command, args []string) error {
+	check := validator.GetCheckByID(strings.ToUpper(args[0]))
+	if check == nil {
+		return fmt.Errorf("unknown check %q, run \"frontmatter-validator checks list\" to see all checks", args[0])
+	}
+	return writeExplanation(os.Stdout, *check)
+}
+
+// writeExplanation writes the long description, rationale and examples of a check
+func writeExplanation(w io.Writer, check validator.Check) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (%s, group %s)\n\n", check.ID, check.Severity, check.Group)
+	fmt.Fprintf(&b, "%s\n", check.Description)
+	if check.Long != "" {
+		fmt.Fprintf(&b, "\n%s\n", check.Long)
+	}
+	if check.Rationale != "" {
+		fmt.Fprintf(&b, "\nWhy:\n%s\n", indent(check.Rationale))
+	}
+	if check.Good != "" {
+		fmt.Fprintf(&b, "\nGood:\n%s\n", indent(check.Good))
+	}
+	if check.Bad != "" {
+		fmt.Fprintf(&b, "\nBad:\n%s\n", indent(check.Bad))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// indent prefixes every line of s with two spaces
+func indent(s string) string {
+	return "  " + strings.ReplaceAll(s, "\n", "\n  ")
+}
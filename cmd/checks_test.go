@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/config"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+func TestListChecks(t *testing.T) {
+	rules := config.RuleSet{
+		EnabledChecks:  []string{validator.NoTitle, validator.LongTitle},
+		DisabledChecks: []string{validator.LongTitle},
+	}
+
+	entries := listChecks(rules)
+	if len(entries) != len(validator.GetChecks()) {
+		t.Fatalf("listChecks() returned %d entries, want %d", len(entries), len(validator.GetChecks()))
+	}
+
+	enabled := make(map[string]bool)
+	for _, entry := range entries {
+		enabled[entry.ID] = entry.Enabled
+	}
+
+	tests := []struct {
+		check string
+		want  bool
+	}{
+		{validator.NoTitle, true},
+		{validator.LongTitle, false},
+		{validator.NoOwner, false},
+	}
+	for _, tt := range tests {
+		if enabled[tt.check] != tt.want {
+			t.Errorf("enabled[%s] = %v, want %v", tt.check, enabled[tt.check], tt.want)
+		}
+	}
+}
+
+func TestWriteExplanation(t *testing.T) {
+	var b strings.Builder
+	if err := writeExplanation(&b, *validator.GetCheckByID(validator.NoQuestionMark)); err != nil {
+		t.Fatalf("writeExplanation() error = %v", err)
+	}
+
+	want := `NO_QUESTION_MARK (FAIL, group user-questions)
+
+Questions should end with a question mark
+
+Each user question must end with a question mark. The value is the question.
+
+Why:
+  User questions are phrased the way readers ask them, and statements make the list inconsistent.
+
+Good:
+  user_questions:
+    - How do I install the CLI on macOS?
+
+Bad:
+  user_questions:
+    - Installing the CLI on macOS
+`
+	if b.String() != want {
+		t.Errorf("writeExplanation() =\n%s\nwant\n%s", b.String(), want)
+	}
+}
@@ -0,0 +1,328 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/config"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+var configResolveOutputFormat string
+
+// configCmd groups the subcommands about the configuration file
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the configuration file",
+}
+
+// configValidateCmd checks the configuration file for mistakes
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the configuration file for mistakes",
+	Long: `Checks the configuration file for syntax errors, unknown fields and unknown
+check IDs, which are reported as errors, and for duplicate entries and
+directory overrides that match no file below --path, which are reported as
+warnings. Exits with an error if any errors were found.`,
+	Args:         cobra.NoArgs,
+	RunE:         runConfigValidate,
+	SilenceUsage: true,
+}
+
+// configResolveCmd shows how the configuration applies to a file
+var configResolveCmd = &cobra.Command{
+	Use:   "resolve FILE",
+	Short: "Show how the configuration applies to a file",
+	Long: `Prints whether the file is ignored, which directory overrides match it, and the
+resulting enabled checks and thresholds, each with the configuration entry it
+comes from. Severities are built into each check and cannot be configured.`,
+	Args:         cobra.ExactArgs(1),
+	RunE:         runConfigResolve,
+	SilenceUsage: true,
+}
+
+func init() {
+	configResolveCmd.Flags().StringVar(&configResolveOutputFormat, "output", "text", "Output format: 'text' or 'json'")
+	configCmd.AddCommand(configValidateCmd)
+	configCmd.AddCommand(configResolveCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	if noConfig {
+		return fmt.Errorf("--no-config uses the built-in default configuration, there is no configuration file to validate")
+	}
+
+	// Loading the configuration also checks that extended configurations
+	// exist and don't extend each other in a cycle. Its content extensions
+	// decide which files the patterns are checked against.
+	configManager, loadErr := loadConfig()
+	var cfg *config.Config
+	if loadErr == nil {
+		cfg = configManager.GetConfig()
+	}
+
+	contentFiles, err := walkMarkdownFiles(targetPath, contentExtensions(cfg), walkOptions()...)
+	if err != nil {
+		return fmt.Errorf("failed to list files in %s: %w", targetPath, err)
+	}
+
+	knownChecks := make(map[string]bool)
+	for _, check := range validator.GetChecks() {
+		knownChecks[check.ID] = true
+	}
+
+	paths := configPaths
+	discovered := len(configPaths) == 0
+	if discovered {
+		discovery, err := config.Discover(targetPath, skipOptions()...)
+		if err != nil {
+			return fmt.Errorf("failed to discover configuration files for %s: %w", targetPath, err)
+		}
+		paths = append(discovery.Files, discovery.Nested...)
+		if len(paths) == 0 {
+			return fmt.Errorf("no %s found for %s", config.FileName, targetPath)
+		}
+	}
+
+	errorCount, warningCount := 0, 0
+	for _, configPath := range paths {
+		if !fileExists(configPath) {
+			return fmt.Errorf("configuration file %s not found", configPath)
+		}
+
+		data, err := os.ReadFile(configPath)
+		if err != nil {
+			return fmt.Errorf("failed to read config file %s: %w", configPath, err)
+		}
+
+		// Patterns of discovered files are relative to their directory
+		files := contentFiles
+		if discovered {
+			files = filesBelow(filepath.Dir(configPath), contentFiles)
+		}
+
+		for _, problem := range config.Validate(data, knownChecks, files) {
+			if problem.Severity == config.ProblemError {
+				errorCount++
+			} else {
+				warningCount++
+			}
+			fmt.Printf("%s: %s: %s\n", configPath, problem.Severity, problem)
+		}
+	}
+
+	if loadErr != nil {
+		errorCount++
+		fmt.Printf("%s: %v\n", config.ProblemError, loadErr)
+	}
+
+	files := strings.Join(paths, ", ")
+	if errorCount > 0 {
+		return fmt.Errorf("%s and %s in %s", count(errorCount, "error"), count(warningCount, "warning"), files)
+	}
+	if warningCount > 0 {
+		fmt.Printf("%s in %s\n", count(warningCount, "warning"), files)
+	} else {
+		fmt.Printf("No problems found in %s\n", files)
+	}
+	return nil
+}
+
+// filesBelow returns the files below a directory, relative to it
+func filesBelow(dir string, files []string) []string {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil
+	}
+	below := []string{}
+	for _, file := range files {
+		absFile, err := filepath.Abs(file)
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(absDir, absFile)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		below = append(below, filepath.ToSlash(rel))
+	}
+	return below
+}
+
+// resolveReport is the result of "config resolve"
+type resolveReport struct {
+	Config           string              `json:"config"`
+	File             string              `json:"file"`
+	IgnoredBy        string              `json:"ignored_by,omitempty"`
+	MatchedOverrides []resolvedOverride  `json:"matched_overrides"`
+	Checks           []resolvedCheck     `json:"checks"`
+	Thresholds       []resolvedThreshold `json:"thresholds"`
+}
+
+// resolvedOverride is a directory override matching the file
+type resolvedOverride struct {
+	Index int    `json:"index"`
+	Path  string `json:"path"`
+	File  string `json:"file,omitempty"`
+}
+
+// resolvedCheck is the final state of a check for the file
+type resolvedCheck struct {
+	ID       string `json:"id"`
+	Severity string `json:"severity,omitempty"`
+	Enabled  bool   `json:"enabled"`
+	Source   string `json:"source"`
+	File     string `json:"file,omitempty"`
+}
+
+// resolvedThreshold is a single bound of a threshold
+type resolvedThreshold struct {
+	Name   string `json:"name"`
+	Value  int    `json:"value"`
+	Source string `json:"source"`
+	File   string `json:"file,omitempty"`
+}
+
+func runConfigResolve(cmd *cobra.Command, args []string) error {
+	configManager, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	report := resolveConfig(configManager, describeConfig(configManager), args[0])
+
+	switch configResolveOutputFormat {
+	case "text":
+		return writeResolveReport(os.Stdout, report)
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	default:
+		return fmt.Errorf("unknown output format %q", configResolveOutputFormat)
+	}
+}
+
+// resolveConfig explains how the configuration applies to a file. Checks are
+// listed in logical order, followed by unknown check IDs from the configuration.
+func resolveConfig(configManager *config.Manager, configSource, filePath string) resolveReport {
+	cfg := configManager.GetConfig()
+	resolution := configManager.Resolve(filePath)
+
+	report := resolveReport{
+		Config:           configSource,
+		File:             filePath,
+		IgnoredBy:        resolution.IgnoredBy,
+		MatchedOverrides: []resolvedOverride{},
+	}
+
+	for _, override := range resolution.MatchedOverrides {
+		report.MatchedOverrides = append(report.MatchedOverrides, resolvedOverride(override))
+	}
+
+	decisions := make(map[string]config.CheckDecision)
+	for _, decision := range resolution.Checks {
+		decisions[decision.Check] = decision
+	}
+
+	for _, check := range validator.GetChecks() {
+		entry := resolvedCheck{ID: check.ID, Severity: check.Severity, Source: "not listed in the configuration"}
+		if decision, ok := decisions[check.ID]; ok {
+			entry.Enabled = decision.Enabled
+			entry.Source = decision.Source
+			entry.File = decision.File
+			delete(decisions, check.ID)
+		}
+		report.Checks = append(report.Checks, entry)
+	}
+	for _, decision := range resolution.Checks {
+		if _, ok := decisions[decision.Check]; ok {
+			report.Checks = append(report.Checks, resolvedCheck{ID: decision.Check, Enabled: decision.Enabled, Source: decision.Source + ", unknown check", File: decision.File})
+		}
+	}
+
+	report.Thresholds = append(report.Thresholds, resolveRange("expiration_in_days", cfg.Thresholds.ExpirationInDays, validator.DefaultExpirationBounds)...)
+	report.Thresholds = append(report.Thresholds, resolveRange("weight", cfg.Thresholds.Weight, validator.DefaultWeightBounds)...)
+	for i, threshold := range report.Thresholds {
+		report.Thresholds[i].File = configManager.Source("thresholds." + threshold.Name)
+	}
+
+	return report
+}
+
+// resolveRange returns both bounds of a threshold with their source
+func resolveRange(name string, r config.Range, defaults validator.Bounds) []resolvedThreshold {
+	bound := func(suffix string, value *int, fallback int) resolvedThreshold {
+		if value != nil {
+			return resolvedThreshold{Name: name + "." + suffix, Value: *value, Source: "thresholds." + name + "." + suffix}
+		}
+		return resolvedThreshold{Name: name + "." + suffix, Value: fallback, Source: "built-in default"}
+	}
+	return []resolvedThreshold{
+		bound("min", r.Min, defaults.Min),
+		bound("max", r.Max, defaults.Max),
+	}
+}
+
+// writeResolveReport writes the result of "config resolve" as text
+func writeResolveReport(w io.Writer, report resolveReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Configuration: %s\n", report.Config)
+	fmt.Fprintf(tw, "File: %s\n", report.File)
+	if report.IgnoredBy != "" {
+		fmt.Fprintf(tw, "Ignored: yes, by ignore_paths pattern %q\n", report.IgnoredBy)
+	} else {
+		fmt.Fprintln(tw, "Ignored: no")
+	}
+
+	fmt.Fprintln(tw, "Matched overrides:")
+	if len(report.MatchedOverrides) == 0 {
+		fmt.Fprintln(tw, "  none")
+	}
+	for _, override := range report.MatchedOverrides {
+		fmt.Fprintf(tw, "  directory_overrides[%d]: %s (%s)\n", override.Index, override.Path, orDash(override.File))
+	}
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "CHECK\tSEVERITY\tENABLED\tSOURCE\tFILE")
+	for _, check := range report.Checks {
+		enabled := "no"
+		if check.Enabled {
+			enabled = "yes"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", check.ID, orDash(check.Severity), enabled, check.Source, orDash(check.File))
+	}
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "THRESHOLD\tVALUE\tSOURCE\tFILE")
+	for _, threshold := range report.Thresholds {
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", threshold.Name, threshold.Value, threshold.Source, orDash(threshold.File))
+	}
+
+	return tw.Flush()
+}
+
+// orDash returns "-" for empty strings
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// count formats a number with a noun, like "1 error" or "2 errors"
+func count(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
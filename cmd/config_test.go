@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/config"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+func TestResolveConfig(t *testing.T) {
+	// Without a configuration file, the built-in defaults apply
+	configManager, err := config.NewManager()
+	if err != nil {
+		t.Fatalf("NewManager() error = %v", err)
+	}
+
+	report := resolveConfig(configManager, "defaults", "src/content/vintage/page.md")
+
+	if want := []resolvedOverride{}; !reflect.DeepEqual(report.MatchedOverrides, want) {
+		t.Errorf("MatchedOverrides = %v, want %v", report.MatchedOverrides, want)
+	}
+
+	checks := make(map[string]resolvedCheck)
+	for _, check := range report.Checks {
+		checks[check.ID] = check
+	}
+
+	tests := []struct {
+		check string
+		want  resolvedCheck
+	}{
+		{validator.NoTitle, resolvedCheck{ID: validator.NoTitle, Severity: validator.SeverityFail, Enabled: true, Source: "default_rules.enabled_checks", File: config.DefaultsSource}},
+		{validator.ReviewTooLongAgo, resolvedCheck{ID: validator.ReviewTooLongAgo, Severity: validator.SeverityWarn, Enabled: true, Source: "default_rules.enabled_checks", File: config.DefaultsSource}},
+		{validator.NoDiataxisContentType, resolvedCheck{ID: validator.NoDiataxisContentType, Severity: validator.SeverityFail, Enabled: false, Source: "not listed in the configuration"}},
+	}
+	for _, tt := range tests {
+		if checks[tt.check] != tt.want {
+			t.Errorf("check %s = %+v, want %+v", tt.check, checks[tt.check], tt.want)
+		}
+	}
+}
+
+func TestResolveRange(t *testing.T) {
+	limit := 50
+	got := resolveRange("weight", config.Range{Max: &limit}, validator.Bounds{Min: -10, Max: 10})
+	want := []resolvedThreshold{
+		{Name: "weight.min", Value: -10, Source: "built-in default"},
+		{Name: "weight.max", Value: 50, Source: "thresholds.weight.max"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("resolveRange() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/fix"
+)
+
+var (
+	fixDateOrder string
+	fixDryRun    bool
+)
+
+// fixCmd rewrites frontmatter values that can be corrected automatically
+var fixCmd = &cobra.Command{
+	Use:   "fix [files...]",
+	Short: "Rewrite non-ISO dates in frontmatter to YYYY-MM-DD",
+	Long: `Rewrites last_review_date and date values written as MM/DD/YYYY or DD/MM/YYYY
+to YYYY-MM-DD, which resolves NON_ISO_DATE findings.
+
+Dates where the order of day and month is evident, like 25/03/2025, are always
+rewritten. For ambiguous dates like 03/04/2025, pass --date-order=mdy or
+--date-order=dmy; without it, they are reported and left unchanged.`,
+	Args:         cobra.ArbitraryArgs,
+	RunE:         runFix,
+	SilenceUsage: true,
+}
+
+func init() {
+	fixCmd.Flags().StringVar(&fixDateOrder, "date-order", "", "How to read ambiguous NN/NN/YYYY dates: 'mdy' or 'dmy'")
+	fixCmd.Flags().BoolVar(&fixDryRun, "dry-run", false, "Only print the changes, don't write files")
+	rootCmd.AddCommand(fixCmd)
+}
+
+func runFix(cmd *cobra.Command, args []string) error {
+	order, err := fix.ParseDateOrder(fixDateOrder)
+	if err != nil {
+		return err
+	}
+
+	configManager, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	files, err := getFilesToProcess(args, configManager.GetConfig())
+	if err != nil {
+		return fmt.Errorf("failed to get files to process: %w", err)
+	}
+	defer files.Close()
+	if files.archive != nil {
+		return fmt.Errorf("can't fix files inside the archive %s", targetPath)
+	}
+
+	nSkipped := 0
+	for _, filePath := range files.paths {
+		if !fileExists(filePath) || configManager.IsPathIgnored(filePath) {
+			continue
+		}
+
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", filePath, err)
+		}
+
+		fixed, changes := fix.Dates(string(content), order)
+		nChanged := 0
+		for _, change := range changes {
+			if change.Skipped {
+				nSkipped++
+				fmt.Fprintf(os.Stderr, "%s:%d: %s %s not rewritten: %s\n", filePath, change.Line, change.Key, change.Old, change.Reason)
+				continue
+			}
+			nChanged++
+			fmt.Printf("%s:%d: %s %s -> %s\n", filePath, change.Line, change.Key, change.Old, change.New)
+		}
+
+		if nChanged > 0 && !fixDryRun {
+			if err := os.WriteFile(filePath, []byte(fixed), info.Mode().Perm()); err != nil {
+				return fmt.Errorf("failed to write %s: %w", filePath, err)
+			}
+		}
+	}
+
+	if nSkipped > 0 {
+		return fmt.Errorf("%d date(s) could not be rewritten", nSkipped)
+	}
+
+	return nil
+}
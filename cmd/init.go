@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/config"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+var (
+	initDryRun    bool
+	initForce     bool
+	initThreshold float64
+)
+
+// initCmd proposes a configuration for an existing content tree
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Propose a configuration file for the content tree",
+	Long: `Runs every check on the Markdown files below --path and writes a configuration
+file to the --config location, or to ` + config.FileName + ` in the working
+directory, that matches the current state of the content.
+
+Checks that pass everywhere are enabled. Checks that fail in at least the
+--threshold share of all files are disabled. Other failing checks stay enabled,
+and are disabled for each directory where they fail in at least the
+--threshold share of files. The number of failing files is noted as a comment
+next to each check.`,
+	Args:         cobra.NoArgs,
+	RunE:         runInit,
+	SilenceUsage: true,
+}
+
+func init() {
+	initCmd.Flags().BoolVar(&initDryRun, "dry-run", false, "Print the proposed configuration instead of writing it")
+	initCmd.Flags().BoolVar(&initForce, "force", false, "Overwrite an existing configuration file")
+	initCmd.Flags().Float64Var(&initThreshold, "threshold", config.DefaultScaffoldThreshold, "Share of failing files, between 0 and 1, from which a check is disabled")
+	rootCmd.AddCommand(initCmd)
+}
+
+// allChecksConfig enables every check for every path
+type allChecksConfig struct {
+	checks []string
+}
+
+func (c allChecksConfig) GetEnabledChecksForPath(filePath string) []string {
+	return c.checks
+}
+
+func (c allChecksConfig) IsPathIgnored(filePath string) bool {
+	return false
+}
+
+func runInit(cmd *cobra.Command, args []string) error {
+	if len(configPaths) > 1 {
+		return fmt.Errorf("init writes a single configuration file, but --config was given %d times", len(configPaths))
+	}
+	configPath := config.FileName
+	if len(configPaths) == 1 {
+		configPath = configPaths[0]
+	}
+
+	if initThreshold <= 0 || initThreshold > 1 {
+		return fmt.Errorf("invalid --threshold value %v, expected a value above 0 and up to 1", initThreshold)
+	}
+	if !initDryRun && !initForce && fileExists(configPath) {
+		return fmt.Errorf("configuration file %s already exists, use --force to overwrite it", configPath)
+	}
+
+	// The proposal replaces the configuration, but settings like the timezone
+	// and content extensions of an existing one apply to the analysis
+	cfg, err := loadInitConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	filePaths, err := walkMarkdownFiles(targetPath, contentExtensions(cfg), walkOptions()...)
+	if err != nil {
+		return fmt.Errorf("failed to list files in %s: %w", targetPath, err)
+	}
+	if len(filePaths) == 0 {
+		return fmt.Errorf("no Markdown files found in %s", targetPath)
+	}
+
+	var checkIDs []string
+	for _, check := range validator.GetChecks() {
+		checkIDs = append(checkIDs, check.ID)
+	}
+
+	clock, location, err := newClock(cfg)
+	if err != nil {
+		return err
+	}
+	v := validator.NewWithConfig(allChecksConfig{checks: checkIDs},
+		validator.WithClock(clock),
+		validator.WithLocation(location),
+	)
+
+	failures := make(map[string][]string)
+	addFailures := func(filePath string, checks []validator.CheckResult) {
+		seen := make(map[string]bool)
+		for _, check := range checks {
+			if !seen[check.Check] {
+				seen[check.Check] = true
+				failures[check.Check] = append(failures[check.Check], filePath)
+			}
+		}
+	}
+
+	contents := make(map[string]string)
+	for _, filePath := range filePaths {
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", filePath, err)
+		}
+		contents[filePath] = string(content)
+		addFailures(filePath, v.ValidateFile(string(content), filePath).Checks)
+	}
+	for filePath, linkChecks := range v.ValidateLinks(contents) {
+		addFailures(filePath, linkChecks)
+	}
+
+	scaffold := config.NewScaffold(filePaths, checkIDs, failures, initThreshold)
+
+	if initDryRun {
+		return scaffold.WriteYAML(os.Stdout)
+	}
+
+	file, err := os.Create(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", configPath, err)
+	}
+	if err := scaffold.WriteYAML(file); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write %s: %w", configPath, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", configPath, err)
+	}
+
+	fmt.Printf("Wrote %s with %d enabled checks, %d disabled checks and %d directory overrides, based on %d files\n",
+		configPath, len(scaffold.Enabled), len(scaffold.Disabled), len(scaffold.Overrides), len(filePaths))
+	return nil
+}
+
+// loadInitConfig loads the configuration that applies to --path, like a
+// validation run, without the notice about a missing configuration file. With
+// --config, the file is only loaded if it already exists.
+func loadInitConfig(configPath string) (*config.Config, error) {
+	var manager *config.Manager
+	var err error
+	switch {
+	case noConfig:
+		manager, err = config.NewManager()
+	case len(configPaths) > 0 && !fileExists(configPath):
+		manager, err = config.NewManager()
+	case len(configPaths) > 0:
+		manager, err = config.NewManager(configPath)
+	default:
+		var discovery config.Discovery
+		discovery, err = config.Discover(targetPath, skipOptions()...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover configuration files for %s: %w", targetPath, err)
+		}
+		manager, err = config.NewDiscoveredManager(discovery)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return manager.GetConfig(), nil
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/config"
+)
+
+func TestLoadInitConfig(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, config.FileName), []byte("timezone: Europe/Berlin\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "custom.yaml"), []byte("timezone: Asia/Tokyo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	tests := []struct {
+		name         string
+		configPaths  []string
+		noConfig     bool
+		wantTimezone string
+	}{
+		{name: "discovered file", wantTimezone: "Europe/Berlin"},
+		{name: "existing explicit file", configPaths: []string{"custom.yaml"}, wantTimezone: "Asia/Tokyo"},
+		{name: "new explicit file", configPaths: []string{"new.yaml"}, wantTimezone: ""},
+		{name: "no config", noConfig: true, wantTimezone: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPaths, noConfig, targetPath = tt.configPaths, tt.noConfig, "."
+			t.Cleanup(func() { configPaths, noConfig = nil, false })
+
+			configPath := config.FileName
+			if len(tt.configPaths) == 1 {
+				configPath = tt.configPaths[0]
+			}
+			cfg, err := loadInitConfig(configPath)
+			if err != nil {
+				t.Fatalf("loadInitConfig() error = %v", err)
+			}
+			if cfg.Timezone != tt.wantTimezone {
+				t.Errorf("Timezone = %q, want %q", cfg.Timezone, tt.wantTimezone)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/output"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// reportFormats lists the formats that can be written with --report
+var reportFormats = []string{"json", "review-issues", "junit", "gitlab-codequality", "checkstyle", "sarif", "html", "markdown", "github", "annotations"}
+
+// linkFormats lists the formats that link to pages using the review issue
+// link base
+var linkFormats = []string{"review-issues", "html"}
+
+// linksPages reports whether any of the formats links to pages
+func linksPages(formats []string) bool {
+	for _, format := range formats {
+		if containsString(linkFormats, format) {
+			return true
+		}
+	}
+	return false
+}
+
+// reportSpec is a report format and the path it is written to. The path "-"
+// stands for stdout.
+type reportSpec struct {
+	Format string
+	Path   string
+}
+
+// parseReportSpecs parses --report values of the form format=path
+func parseReportSpecs(values []string) ([]reportSpec, error) {
+	var specs []reportSpec
+	for _, value := range values {
+		format, path, found := strings.Cut(value, "=")
+		if !found || format == "" || path == "" {
+			return nil, fmt.Errorf("invalid --report value %q, expected format=path", value)
+		}
+		if !containsString(reportFormats, format) {
+			return nil, fmt.Errorf("unknown report format %q, expected one of: %s", format, strings.Join(reportFormats, ", "))
+		}
+		specs = append(specs, reportSpec{Format: format, Path: path})
+	}
+	return specs, nil
+}
+
+// writeFormat writes the results in the given format
+func writeFormat(w io.Writer, format string, formatter *output.Formatter, files []string, results map[string]validator.ValidationResult) error {
+	switch format {
+	case "json":
+		return formatter.WriteJSON(w, files, results)
+	case "review-issues":
+		return formatter.WriteReviewIssues(w, results)
+	case "junit":
+		return formatter.WriteJUnit(w, files, results, junitWarn)
+	case "gitlab-codequality":
+		return formatter.WriteCodeQuality(w, results)
+	case "checkstyle":
+		return formatter.WriteCheckstyle(w, results)
+	case "sarif":
+		return formatter.WriteSARIF(w, results)
+	case "html":
+		return formatter.WriteHTML(w, files, results)
+	case "markdown":
+		return formatter.WriteMarkdown(w, results)
+	case "github":
+		return formatter.WriteGitHub(w, results)
+	case "annotations":
+		return formatter.WriteAnnotations(w, results)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// writeReports writes each requested report to its file
+func writeReports(specs []reportSpec, formatter *output.Formatter, files []string, results map[string]validator.ValidationResult) error {
+	for _, spec := range specs {
+		if spec.Path == "-" {
+			if err := writeFormat(os.Stdout, spec.Format, formatter, files, results); err != nil {
+				return fmt.Errorf("failed to write %s report: %w", spec.Format, err)
+			}
+			continue
+		}
+
+		file, err := os.Create(spec.Path)
+		if err != nil {
+			return fmt.Errorf("failed to create %s report: %w", spec.Format, err)
+		}
+		err = writeFormat(file, spec.Format, formatter, files, results)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write %s report to %s: %w", spec.Format, spec.Path, err)
+		}
+	}
+	return nil
+}
+
+// Color modes for the human-readable output
+const (
+	colorAuto   = "auto"
+	colorAlways = "always"
+	colorNever  = "never"
+)
+
+// useColor decides whether to color the human-readable output. In auto mode,
+// a non-empty NO_COLOR disables colors and a non-empty FORCE_COLOR other than
+// "0" enables them; otherwise colors are used when stdout is a terminal.
+func useColor(mode string, getenv func(string) string, isTerminal bool) (bool, error) {
+	switch mode {
+	case colorAlways:
+		return true, nil
+	case colorNever:
+		return false, nil
+	case colorAuto:
+		if getenv("NO_COLOR") != "" {
+			return false, nil
+		}
+		if force := getenv("FORCE_COLOR"); force != "" && force != "0" {
+			return true, nil
+		}
+		return isTerminal, nil
+	default:
+		return false, fmt.Errorf("invalid --color value %q, expected 'auto', 'always' or 'never'", mode)
+	}
+}
+
+// stdoutIsTerminal reports whether stdout is connected to a terminal
+func stdoutIsTerminal() bool {
+	stat, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return stat.Mode()&os.ModeCharDevice != 0
+}
+
+// writeStepSummary appends a Markdown job summary to the file named by
+// GITHUB_STEP_SUMMARY, if set
+func writeStepSummary(formatter *output.Formatter, results map[string]validator.ValidationResult) error {
+	summaryPath := os.Getenv("GITHUB_STEP_SUMMARY")
+	if summaryPath == "" {
+		return nil
+	}
+
+	file, err := os.OpenFile(summaryPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return formatter.WriteStepSummary(file, results)
+}
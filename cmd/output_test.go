@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseReportSpecs(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []string
+		expected  []reportSpec
+		expectErr bool
+	}{
+		{
+			name:     "no reports",
+			values:   nil,
+			expected: nil,
+		},
+		{
+			name:   "multiple reports",
+			values: []string{"sarif=out.sarif", "junit=reports/junit.xml", "json=-"},
+			expected: []reportSpec{
+				{Format: "sarif", Path: "out.sarif"},
+				{Format: "junit", Path: "reports/junit.xml"},
+				{Format: "json", Path: "-"},
+			},
+		},
+		{
+			name:     "path containing equals sign",
+			values:   []string{"checkstyle=a=b.xml"},
+			expected: []reportSpec{{Format: "checkstyle", Path: "a=b.xml"}},
+		},
+		{
+			name:      "missing path",
+			values:    []string{"junit="},
+			expectErr: true,
+		},
+		{
+			name:      "missing separator",
+			values:    []string{"junit.xml"},
+			expectErr: true,
+		},
+		{
+			name:      "unknown format",
+			values:    []string{"stdout=out.txt"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			specs, err := parseReportSpecs(tt.values)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Expected error, got %v", specs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(specs, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, specs)
+			}
+		})
+	}
+}
+
+func TestUseColor(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       string
+		env        map[string]string
+		isTerminal bool
+		expected   bool
+		expectErr  bool
+	}{
+		{name: "auto on terminal", mode: "auto", isTerminal: true, expected: true},
+		{name: "auto when piped", mode: "auto", isTerminal: false, expected: false},
+		{name: "NO_COLOR on terminal", mode: "auto", env: map[string]string{"NO_COLOR": "1"}, isTerminal: true, expected: false},
+		{name: "FORCE_COLOR when piped", mode: "auto", env: map[string]string{"FORCE_COLOR": "1"}, expected: true},
+		{name: "FORCE_COLOR=0 when piped", mode: "auto", env: map[string]string{"FORCE_COLOR": "0"}, expected: false},
+		{name: "NO_COLOR wins over FORCE_COLOR", mode: "auto", env: map[string]string{"NO_COLOR": "1", "FORCE_COLOR": "1"}, expected: false},
+		{name: "always ignores NO_COLOR", mode: "always", env: map[string]string{"NO_COLOR": "1"}, expected: true},
+		{name: "never ignores FORCE_COLOR", mode: "never", env: map[string]string{"FORCE_COLOR": "1"}, isTerminal: true, expected: false},
+		{name: "invalid mode", mode: "sometimes", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string { return tt.env[key] }
+			color, err := useColor(tt.mode, getenv, tt.isTerminal)
+			if tt.expectErr {
+				if err == nil {
+					t.Error("Expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if color != tt.expected {
+				t.Errorf("Expected color %v, got %v", tt.expected, color)
+			}
+		})
+	}
+}
+
+func TestLinksPages(t *testing.T) {
+	tests := []struct {
+		name     string
+		formats  []string
+		expected bool
+	}{
+		{name: "stdout", formats: []string{"stdout"}, expected: false},
+		{name: "no links", formats: []string{"json", "junit", "checkstyle"}, expected: false},
+		{name: "review issues", formats: []string{"stdout", "review-issues"}, expected: true},
+		{name: "html report", formats: []string{"github", "html"}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := linksPages(tt.formats); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/report"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+var reportOutputFormat string
+
+// reportCmd groups the reporting subcommands
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Generate reports about the content tree",
+}
+
+// reportReviewsCmd creates the review-due forecast
+var reportReviewsCmd = &cobra.Command{
+	Use:   "reviews [files...]",
+	Short: "Forecast which pages are due for review, grouped by owner team",
+	Long: `Lists every page with its last_review_date, its effective expiration_in_days and
+the resulting due date. Pages are bucketed into missing (no last_review_date),
+overdue, due within 30, 60 and 90 days, and later, and grouped by owner team.
+
+Only pages for which REVIEW_TOO_LONG_AGO is enabled in the configuration are included.`,
+	Args:         cobra.ArbitraryArgs,
+	RunE:         runReviewsReport,
+	SilenceUsage: true,
+}
+
+func init() {
+	reportReviewsCmd.Flags().StringVar(&reportOutputFormat, "output", "table", "Output format: 'table', 'csv' or 'json'")
+	reportCmd.AddCommand(reportReviewsCmd)
+	rootCmd.AddCommand(reportCmd)
+}
+
+func runReviewsReport(cmd *cobra.Command, args []string) error {
+	configManager, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	clock, location, err := newClock(configManager.GetConfig())
+	if err != nil {
+		return err
+	}
+
+	files, err := getFilesToProcess(args, configManager.GetConfig())
+	if err != nil {
+		return fmt.Errorf("failed to get files to process: %w", err)
+	}
+	defer files.Close()
+
+	today := clock.Now().In(location)
+	var entries []report.ReviewEntry
+
+	for _, filePath := range files.paths {
+		if !files.exists(filePath) || configManager.IsPathIgnored(filePath) {
+			continue
+		}
+		if !containsString(configManager.GetEnabledChecksForPath(filePath), validator.ReviewTooLongAgo) {
+			continue
+		}
+
+		content, err := files.readFile(filePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Could not read file %s: %v\n", filePath, err)
+			continue
+		}
+
+		fm, err := validator.ParseFrontMatter(string(content))
+		if err != nil || fm == nil {
+			continue
+		}
+
+		entries = append(entries, report.NewReviewEntry(filePath, fm, today))
+	}
+
+	reviews := report.NewReviewReport(entries, today)
+
+	switch reportOutputFormat {
+	case "table":
+		return reviews.WriteTable(os.Stdout)
+	case "csv":
+		return reviews.WriteCSV(os.Stdout)
+	case "json":
+		return reviews.WriteJSON(os.Stdout)
+	default:
+		return fmt.Errorf("unknown output format %q", reportOutputFormat)
+	}
+}
+
+// containsString reports whether a slice contains the given string
+func containsString(values []string, s string) bool {
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
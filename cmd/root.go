@@ -3,22 +3,38 @@ package cmd
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
+	_ "time/tzdata" // Timezone names must resolve on systems without zoneinfo
 
 	"github.com/spf13/cobra"
 
 	"github.com/giantswarm/frontmatter-validator/pkg/config"
+	"github.com/giantswarm/frontmatter-validator/pkg/content"
 	"github.com/giantswarm/frontmatter-validator/pkg/output"
+	"github.com/giantswarm/frontmatter-validator/pkg/project"
 	"github.com/giantswarm/frontmatter-validator/pkg/validator"
 )
 
 var (
 	outputFormat string
 	targetPath   string
-	configPath   string
+	configPaths  []string
+	noConfig     bool
+	nowDate      string
+	includes     []string
+	excludes     []string
+	noGitignore  bool
+	junitWarn    string
+	reports      []string
+	annotations  string
+	maxFindings  int
+	colorMode    string
+	textFormat   string
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -42,62 +58,158 @@ func Execute() error {
 }
 
 func init() {
-	rootCmd.Flags().StringVar(&outputFormat, "output", "stdout", "Output format: 'json' or 'stdout'")
-	rootCmd.Flags().StringVar(&targetPath, "path", ".", "Target path to scan for Markdown files")
-	rootCmd.Flags().StringVar(&configPath, "config", "./frontmatter-validator.yaml", "Path to configuration file")
+	rootCmd.Flags().StringVar(&outputFormat, "output", "stdout", "Output format: 'stdout', 'json', 'review-issues', 'junit', 'gitlab-codequality', 'checkstyle', 'sarif', 'html', 'markdown' or 'github' (default 'github' in GitHub Actions)")
+	rootCmd.Flags().StringArrayVar(&reports, "report", nil, "Additionally write a report as format=path, e.g. 'sarif=out.sarif' (repeatable, path '-' for stdout). Formats: "+strings.Join(reportFormats, ", "))
+	rootCmd.Flags().StringVar(&annotations, "annotations-file", "", "Write GitHub annotations for the annotations-action to this file")
+	rootCmd.Flags().StringVar(&colorMode, "color", colorAuto, "Color the stdout output: 'auto', 'always' or 'never'. In auto mode, NO_COLOR and FORCE_COLOR are honored")
+	rootCmd.Flags().StringVar(&textFormat, "format", output.StyleFull, "Style of the stdout output: 'full' or 'short' (path:line: SEVERITY CHECK message)")
+	rootCmd.Flags().IntVar(&maxFindings, "max-findings", 0, "Maximum number of findings listed in the markdown output, 0 for all")
+	rootCmd.Flags().StringVar(&junitWarn, "junit-warnings", output.JUnitWarningsSystemOut, "How JUnit output reports WARN findings: 'system-out' or 'skipped'")
+	rootCmd.PersistentFlags().StringVar(&targetPath, "path", ".", "Target path to scan for Markdown files")
+	rootCmd.PersistentFlags().StringArrayVar(&configPaths, "config", nil, "Path to configuration file (repeatable, later files take precedence). By default, "+config.FileName+" files are discovered from --path up to the repository root and in subdirectories")
+	rootCmd.PersistentFlags().BoolVar(&noConfig, "no-config", false, "Use the built-in default configuration instead of configuration files")
+	rootCmd.PersistentFlags().StringArrayVar(&includes, "include", nil, "Only scan files below --path that match this gitignore-style pattern, like 'docs/**' (repeatable)")
+	rootCmd.PersistentFlags().StringArrayVar(&excludes, "exclude", nil, "Skip files and directories below --path that match this gitignore-style pattern, like 'public/' (repeatable)")
+	rootCmd.PersistentFlags().BoolVar(&noGitignore, "no-gitignore", false, "Also scan files ignored by .gitignore files and .git/info/exclude")
+	rootCmd.PersistentFlags().StringVar(&nowDate, "now", "", "Evaluate date checks as of this date (YYYY-MM-DD) instead of today")
 }
 
 func runValidation(cmd *cobra.Command, args []string) error {
+	if junitWarn != output.JUnitWarningsSystemOut && junitWarn != output.JUnitWarningsSkipped {
+		return fmt.Errorf("invalid --junit-warnings value %q, expected 'system-out' or 'skipped'", junitWarn)
+	}
+
+	color, err := useColor(colorMode, os.Getenv, stdoutIsTerminal())
+	if err != nil {
+		return err
+	}
+	if textFormat != output.StyleFull && textFormat != output.StyleShort {
+		return fmt.Errorf("invalid --format value %q, expected 'full' or 'short'", textFormat)
+	}
+
+	specs, err := parseReportSpecs(reports)
+	if err != nil {
+		return err
+	}
+	if annotations != "" {
+		specs = append(specs, reportSpec{Format: "annotations", Path: annotations})
+	}
+
+	// In GitHub Actions, annotate directly unless another format was requested
+	if os.Getenv("GITHUB_ACTIONS") != "" && !cmd.Flags().Changed("output") {
+		outputFormat = "github"
+	}
+	if outputFormat != "stdout" && !containsString(reportFormats, outputFormat) {
+		return fmt.Errorf("unknown output format %q", outputFormat)
+	}
+
 	// Load configuration
-	configManager, err := config.NewManager(configPath)
+	configManager, err := loadConfig()
 	if err != nil {
-		return fmt.Errorf("failed to load configuration: %w", err)
+		return err
 	}
 
 	// Create validator with configuration
-	v := validator.NewWithConfig(configManager)
-	formatter := output.New()
+	v, err := newValidator(configManager)
+	if err != nil {
+		return err
+	}
+
+	runInfo := output.RunInfo{
+		Tool:        project.Name(),
+		ToolVersion: project.Version(),
+		Date:        v.Today().Format("2006-01-02"),
+		Path:        targetPath,
+	}
+	runInfo.ConfigFile = strings.Join(configFiles(configManager), ", ")
+
+	formats := []string{outputFormat}
+	for _, spec := range specs {
+		formats = append(formats, spec.Format)
+	}
+	reviewIssues, err := newReviewIssues(configManager.GetConfig(), linksPages(formats))
+	if err != nil {
+		return err
+	}
+
+	formatter := output.New(
+		output.WithRunInfo(runInfo),
+		output.WithReviewIssues(reviewIssues),
+		output.WithOwnerMapping(newOwnerMapping(configManager.GetConfig().OwnerMapping)),
+		output.WithMaxFindings(maxFindings),
+		output.WithColor(color),
+		output.WithStyle(textFormat),
+	)
 	results := make(map[string]validator.ValidationResult)
+	contents := make(map[string]string)
+	var validated []string
 
 	// Get list of files to process
-	filePaths, err := getFilesToProcess(args)
+	files, err := getFilesToProcess(args, configManager.GetConfig())
 	if err != nil {
 		return fmt.Errorf("failed to get files to process: %w", err)
 	}
+	defer files.Close()
 
 	// Process each file
-	for _, filePath := range filePaths {
-		if !fileExists(filePath) {
+	for _, filePath := range files.paths {
+		if !files.exists(filePath) {
 			continue
 		}
 
-		content, err := os.ReadFile(filePath)
+		content, err := files.readFile(filePath)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: Could not read file %s: %v\n", filePath, err)
 			continue
 		}
 
-		result := v.ValidateFile(string(content), filePath)
-		if len(result.Checks) > 0 {
-			results[filePath] = result
+		contents[filePath] = string(content)
+		if !configManager.IsPathIgnored(filePath) {
+			validated = append(validated, filePath)
+		}
+
+		results[filePath] = v.ValidateFile(string(content), filePath)
+	}
+
+	// Links may point to pages that are not validated, because they were not
+	// passed as arguments or are excluded, so they are resolved against the
+	// whole content tree
+	tree := contents
+	if linkChecksEnabled(configManager, validated) {
+		if tree, err = linkTree(files, configManager.GetConfig(), contents); err != nil {
+			return fmt.Errorf("failed to read the content tree for link checks: %w", err)
+		}
+	}
+	for filePath, linkChecks := range v.ValidateLinksInTree(contents, tree) {
+		result := results[filePath]
+		result.Checks = append(result.Checks, linkChecks...)
+		results[filePath] = result
+	}
+
+	// Only keep files with findings
+	for filePath, result := range results {
+		if len(result.Checks) == 0 {
+			delete(results, filePath)
 		}
 	}
 
 	// Output results
-	switch outputFormat {
-	case "json":
-		formatter.PrintJSON(results)
-	default:
+	if outputFormat == "stdout" {
 		formatter.PrintStdout(results)
+	} else if err := writeFormat(os.Stdout, outputFormat, formatter, validated, results); err != nil {
+		return fmt.Errorf("failed to write %s output: %w", outputFormat, err)
 	}
 
-	// Create annotations for GitHub Actions if running in CI
-	if os.Getenv("GITHUB_ACTIONS") != "" {
-		if err := formatter.DumpAnnotations(results); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: Could not create annotations file: %v\n", err)
+	if outputFormat == "github" {
+		if err := writeStepSummary(formatter, results); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Could not write job summary: %v\n", err)
 		}
 	}
 
+	if err := writeReports(specs, formatter, validated, results); err != nil {
+		return err
+	}
+
 	// Return error if any validation issues were found
 	if len(results) > 0 {
 		return fmt.Errorf("validation failed: %d file(s) with issues", len(results))
@@ -106,18 +218,261 @@ func runValidation(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// getFilesToProcess returns the list of files to validate.
-// Priority: positional args > stdin > --path directory walk.
-func getFilesToProcess(args []string) ([]string, error) {
+// loadConfig loads the configuration files given with --config, or discovers
+// them for the target path, and names the files in use on stderr. Without
+// configuration files, the built-in defaults are used, with a notice unless
+// --no-config was given.
+func loadConfig() (*config.Manager, error) {
+	var configManager *config.Manager
+	switch {
+	case noConfig:
+		if len(configPaths) > 0 {
+			return nil, fmt.Errorf("--config and --no-config cannot be combined")
+		}
+		manager, err := config.NewManager()
+		if err != nil {
+			return nil, err
+		}
+		configManager = manager
+	case len(configPaths) > 0:
+		manager, err := config.NewManager(configPaths...)
+		if err != nil {
+			return nil, err
+		}
+		configManager = manager
+	default:
+		discovery, err := config.Discover(targetPath, skipOptions()...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover configuration files for %s: %w", targetPath, err)
+		}
+		manager, err := config.NewDiscoveredManager(discovery)
+		if err != nil {
+			return nil, err
+		}
+		configManager = manager
+
+		if len(discovery.Files) == 0 {
+			fmt.Fprintf(os.Stderr, "Notice: No %s found in %s or its parent directories, using the built-in default configuration. Run \"frontmatter-validator init\" to create one, or pass --no-config to use the defaults deliberately.\n", config.FileName, targetPath)
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Using configuration: %s\n", describeConfig(configManager))
+	return configManager, nil
+}
+
+// configFiles returns the configuration files in use, including nested ones
+func configFiles(configManager *config.Manager) []string {
+	files := append([]string{}, configManager.GetConfigPaths()...)
+	return append(files, configManager.GetNestedConfigPaths()...)
+}
+
+// describeConfig names the configuration files in use
+func describeConfig(configManager *config.Manager) string {
+	description := strings.Join(configManager.GetConfigPaths(), ", ")
+	if description == "" {
+		description = config.DefaultsSource
+	}
+	if nested := configManager.GetNestedConfigPaths(); len(nested) > 0 {
+		description += fmt.Sprintf(", nested: %s", strings.Join(nested, ", "))
+	}
+	return description
+}
+
+// newReviewIssues creates the review issue settings from the configuration.
+// The link base is only derived from git when links are written, so runs
+// outside a checkout don't fail for formats without links.
+func newReviewIssues(cfg *config.Config, withLinks bool) (*output.ReviewIssues, error) {
+	settings := cfg.ReviewIssues
+
+	linkBase := settings.LinkBase
+	switch {
+	case linkBase == config.LinkBaseGit && !withLinks:
+		linkBase = ""
+	case linkBase == config.LinkBaseGit:
+		base, err := gitLinkBase()
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive review issue link base from git: %w", err)
+		}
+		linkBase = base
+	}
+
+	return output.NewReviewIssues(linkBase, settings.Title, settings.Message)
+}
+
+// newOwnerMapping converts the owner mapping configuration
+func newOwnerMapping(cfg config.OwnerMapping) output.OwnerMapping {
+	mapping := output.OwnerMapping{
+		DeriveLabels: cfg.Fallback.DeriveLabels == nil || *cfg.Fallback.DeriveLabels,
+		Fallback: output.OwnerRule{
+			Labels:    cfg.Fallback.Labels,
+			Assignees: cfg.Fallback.Assignees,
+			Projects:  cfg.Fallback.Projects,
+		},
+	}
+	for _, rule := range cfg.Owners {
+		mapping.Rules = append(mapping.Rules, output.OwnerRule(rule))
+	}
+	return mapping
+}
+
+// newValidator creates a validator with all settings from the configuration
+func newValidator(configManager *config.Manager) (*validator.Validator, error) {
+	cfg := configManager.GetConfig()
+
+	clock, location, err := newClock(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := append([]validator.Option{
+		validator.WithClock(clock),
+		validator.WithLocation(location),
+	}, cfg.ValidatorOptions()...)
+	return validator.NewWithConfig(configManager, opts...), nil
+}
+
+// newClock returns the clock and timezone for date checks, honoring the --now
+// flag and the timezone configuration setting
+func newClock(cfg *config.Config) (validator.Clock, *time.Location, error) {
+	location := time.Local
+	if cfg != nil && cfg.Timezone != "" {
+		loc, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid timezone %q in configuration: %w", cfg.Timezone, err)
+		}
+		location = loc
+	}
+
+	if nowDate == "" {
+		return validator.SystemClock, location, nil
+	}
+
+	now, err := time.ParseInLocation("2006-01-02", nowDate, location)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid --now value %q, expected YYYY-MM-DD: %w", nowDate, err)
+	}
+	return validator.FixedClock(now), location, nil
+}
+
+// fileSet holds the files to process, on disk or inside an archive given
+// with --path
+type fileSet struct {
+	paths []string
+	// archive is the content of the archive given with --path, nil for files
+	// on disk
+	archive fs.FS
+	closer  io.Closer
+}
+
+// exists reports whether a file of the set exists and is not a directory
+func (s *fileSet) exists(path string) bool {
+	if s.archive != nil {
+		return true
+	}
+	return fileExists(path)
+}
+
+// readFile reads a file of the set
+func (s *fileSet) readFile(path string) ([]byte, error) {
+	if s.archive != nil {
+		return fs.ReadFile(s.archive, path)
+	}
+	return os.ReadFile(path)
+}
+
+// Close closes the archive, if any
+func (s *fileSet) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+// linkChecksEnabled reports whether link checks are enabled for any of the
+// files
+func linkChecksEnabled(configManager *config.Manager, filePaths []string) bool {
+	for _, filePath := range filePaths {
+		for _, check := range configManager.GetEnabledChecksForPath(filePath) {
+			if check == validator.BrokenInternalLink || check == validator.BrokenAnchor {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// linkTree returns the content of all pages that links may point to: the
+// content files below --path, or in the archive, with the same rules for
+// hidden directories and ignored files as the walk of --path. --include and
+// --exclude only limit the files that are validated, so they don't apply.
+// Files already read are taken from contents.
+func linkTree(files *fileSet, cfg *config.Config, contents map[string]string) (map[string]string, error) {
+	extensions := contentExtensions(cfg)
+	var paths []string
+	var err error
+	if files.archive != nil {
+		paths, err = content.MarkdownFiles(files.archive, ".", content.WithExtensions(extensions...), content.WithGitignore(!noGitignore))
+	} else {
+		paths, err = walkMarkdownFiles(targetPath, extensions, content.WithGitignore(!noGitignore))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tree := make(map[string]string, len(paths)+len(contents))
+	for filePath, data := range contents {
+		tree[filePath] = data
+	}
+	for _, filePath := range paths {
+		if _, ok := tree[filePath]; ok {
+			continue
+		}
+		data, err := files.readFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", filePath, err)
+		}
+		tree[filePath] = string(data)
+	}
+	return tree, nil
+}
+
+// walkOptions returns the options for scanning --path given with flags
+func walkOptions() []content.Option {
+	return append(skipOptions(), content.WithInclude(includes...))
+}
+
+// skipOptions returns the options for the directories skipped when scanning
+// --path, which configuration discovery skips as well
+func skipOptions() []content.Option {
+	return []content.Option{
+		content.WithExclude(excludes...),
+		content.WithGitignore(!noGitignore),
+	}
+}
+
+// contentExtensions returns the extensions of content files, from the
+// configuration if it sets them
+func contentExtensions(cfg *config.Config) []string {
+	if cfg != nil && len(cfg.ContentExtensions) > 0 {
+		return cfg.ContentExtensions
+	}
+	return content.DefaultExtensions
+}
+
+// getFilesToProcess returns the set of files to validate.
+// Priority: positional args > stdin > --path directory or archive walk.
+func getFilesToProcess(args []string, cfg *config.Config) (*fileSet, error) {
+	extensions := contentExtensions(cfg)
+
 	// If positional arguments are provided, use them directly
 	if len(args) > 0 {
 		var filePaths []string
 		for _, arg := range args {
-			if strings.HasSuffix(arg, ".md") {
+			if content.HasExtension(arg, extensions) {
 				filePaths = append(filePaths, arg)
 			}
 		}
-		return filePaths, nil
+		return &fileSet{paths: filePaths}, nil
 	}
 
 	var filePaths []string
@@ -133,7 +488,7 @@ func getFilesToProcess(args []string) ([]string, error) {
 		scanner := bufio.NewScanner(os.Stdin)
 		for scanner.Scan() {
 			line := strings.TrimSpace(scanner.Text())
-			if line != "" && strings.HasSuffix(line, ".md") {
+			if line != "" && content.HasExtension(line, extensions) {
 				filePaths = append(filePaths, line)
 				fmt.Printf("Adding to files checked: %s\n", line)
 			}
@@ -141,27 +496,87 @@ func getFilesToProcess(args []string) ([]string, error) {
 		if err := scanner.Err(); err != nil {
 			return nil, err
 		}
-	} else {
-		// Walk the target path
-		err := filepath.WalkDir(targetPath, func(path string, d fs.DirEntry, err error) error {
-			if err != nil {
-				return err
-			}
+		return &fileSet{paths: filePaths}, nil
+	}
 
-			if !d.IsDir() && strings.HasSuffix(path, ".md") {
-				filePaths = append(filePaths, path)
-			}
+	// Walk the target path
+	if content.IsArchive(targetPath) {
+		archive, closer, err := content.OpenArchive(targetPath, content.WithExtensions(extensions...))
+		if err != nil {
+			return nil, err
+		}
+		filePaths, err := content.MarkdownFiles(archive, ".", append(walkOptions(), content.WithExtensions(extensions...))...)
+		if err != nil {
+			closer.Close()
+			return nil, err
+		}
+		return &fileSet{paths: filePaths, archive: archive, closer: closer}, nil
+	}
+	filePaths, err = walkMarkdownFiles(targetPath, extensions, walkOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSet{paths: filePaths}, nil
+}
 
-			return nil
-		})
+// walkMarkdownFiles returns the files with one of the extensions below a
+// directory, or the path itself if it is such a file. Inside a git
+// repository, the walk starts at the repository root, so that .gitignore files
+// of parent directories and .git/info/exclude apply.
+func walkMarkdownFiles(root string, extensions []string, opts ...content.Option) ([]string, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		if !content.HasExtension(root, extensions) {
+			return nil, nil
+		}
+		return []string{root}, nil
+	}
+
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	base, walkRoot := absRoot, "."
+	if repoRoot := repositoryRoot(absRoot); repoRoot != "" {
+		rel, err := filepath.Rel(repoRoot, absRoot)
 		if err != nil {
 			return nil, err
 		}
+		base, walkRoot = repoRoot, filepath.ToSlash(rel)
+	}
+
+	opts = append(opts, content.WithExtensions(extensions...), content.WithDiskRoot(base))
+	paths, err := content.MarkdownFiles(os.DirFS(base), walkRoot, opts...)
+	if err != nil {
+		return nil, err
 	}
 
+	filePaths := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if walkRoot != "." {
+			path = strings.TrimPrefix(path, walkRoot+"/")
+		}
+		filePaths = append(filePaths, filepath.Join(root, filepath.FromSlash(path)))
+	}
 	return filePaths, nil
 }
 
+// repositoryRoot returns the closest directory containing .git, starting at
+// an absolute directory, or an empty string outside of a repository
+func repositoryRoot(dir string) string {
+	for ; ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir
+		}
+		if dir == filepath.Dir(dir) {
+			return ""
+		}
+	}
+}
+
 // fileExists checks if a file exists
 func fileExists(filename string) bool {
 	info, err := os.Stat(filename)
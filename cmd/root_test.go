@@ -1,9 +1,15 @@
 package cmd
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/config"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
 )
 
 func TestGetFilesToProcess_StdinInput(t *testing.T) {
@@ -198,3 +204,204 @@ func TestStdinBugFix(t *testing.T) {
 		})
 	}
 }
+
+func TestNewClock(t *testing.T) {
+	tests := []struct {
+		name         string
+		now          string
+		timezone     string
+		wantDate     string
+		wantLocation string
+		wantErr      bool
+	}{
+		{
+			name:         "no --now flag uses system clock",
+			timezone:     "UTC",
+			wantLocation: "UTC",
+		},
+		{
+			name:         "--now flag in configured timezone",
+			now:          "2025-06-30",
+			timezone:     "Europe/Berlin",
+			wantDate:     "2025-06-30T00:00:00+02:00",
+			wantLocation: "Europe/Berlin",
+		},
+		{
+			name:     "invalid --now flag",
+			now:      "30/06/2025",
+			timezone: "UTC",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid timezone",
+			timezone: "Mars/Olympus_Mons",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nowDate = tt.now
+			defer func() { nowDate = "" }()
+
+			clock, location, err := newClock(&config.Config{Timezone: tt.timezone})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if location.String() != tt.wantLocation {
+				t.Errorf("Expected location %s, got %s", tt.wantLocation, location)
+			}
+			if tt.wantDate != "" && clock.Now().Format(time.RFC3339) != tt.wantDate {
+				t.Errorf("Expected now to be %s, got %s", tt.wantDate, clock.Now().Format(time.RFC3339))
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "custom.yaml"), []byte("default_rules:\n  enabled_checks: [NO_TITLE]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	tests := []struct {
+		name        string
+		configPaths []string
+		noConfig    bool
+		wantPaths   []string
+		wantErr     string
+	}{
+		{name: "explicit file", configPaths: []string{"custom.yaml"}, wantPaths: []string{"custom.yaml"}},
+		{name: "missing explicit file", configPaths: []string{"custom.yaml", "typo.yaml"}, wantErr: "configuration file typo.yaml not found"},
+		{name: "no file discovered", wantPaths: nil},
+		{name: "no config", noConfig: true, wantPaths: nil},
+		{name: "no config with explicit file", configPaths: []string{"custom.yaml"}, noConfig: true, wantErr: "--config and --no-config cannot be combined"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPaths, noConfig, targetPath = tt.configPaths, tt.noConfig, "."
+			t.Cleanup(func() { configPaths, noConfig = nil, false })
+
+			configManager, err := loadConfig()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("loadConfig() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadConfig() error = %v", err)
+			}
+			if got := configManager.GetConfigPaths(); !reflect.DeepEqual(got, tt.wantPaths) {
+				t.Errorf("GetConfigPaths() = %v, want %v", got, tt.wantPaths)
+			}
+		})
+	}
+}
+
+func TestNewReviewIssues_GitLinkBaseWithoutLinks(t *testing.T) {
+	// Outside a git checkout, deriving the link base fails, which must not
+	// matter for formats without links
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("GIT_CEILING_DIRECTORIES", filepath.Dir(dir))
+
+	cfg := &config.Config{ReviewIssues: config.ReviewIssues{LinkBase: config.LinkBaseGit}}
+	reviewIssues, err := newReviewIssues(cfg, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got, want := reviewIssues.URL("docs/a.md"), validator.DocsHost+"docs/a.md"; got != want {
+		t.Errorf("Expected URL %q, got %q", want, got)
+	}
+
+	if _, err := newReviewIssues(cfg, true); err == nil {
+		t.Error("Expected error when links need the git link base outside a checkout")
+	}
+}
+
+func TestWalkMarkdownFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.md", "guide.mdx", "notes.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("---\ntitle: Page\n---\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		root       string
+		extensions []string
+		want       []string
+	}{
+		{name: "directory", root: dir, extensions: []string{".md"}, want: []string{filepath.Join(dir, "a.md")}},
+		{name: "content file", root: filepath.Join(dir, "a.md"), extensions: []string{".md"}, want: []string{filepath.Join(dir, "a.md")}},
+		{name: "configured extension", root: filepath.Join(dir, "guide.mdx"), extensions: []string{".mdx"}, want: []string{filepath.Join(dir, "guide.mdx")}},
+		{name: "file that is not a content file", root: filepath.Join(dir, "notes.txt"), extensions: []string{".md"}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := walkMarkdownFiles(tt.root, tt.extensions)
+			if err != nil {
+				t.Fatalf("walkMarkdownFiles() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("walkMarkdownFiles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunValidation_LinksToPagesNotValidated(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		config.FileName:       "default_rules:\n  enabled_checks: [BROKEN_INTERNAL_LINK]\n",
+		"content/a/_index.md": "---\ntitle: A\n---\n\n[b](/b/)\n",
+		"content/b/_index.md": "---\ntitle: B\n---\n",
+		"content/c/_index.md": "---\ntitle: C\n---\n\n[missing](/missing/)\n",
+		".git/HEAD":           "ref: refs/heads/main\n",
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Chdir(dir)
+
+	tests := []struct {
+		name     string
+		args     []string
+		excludes []string
+		wantErr  bool
+	}{
+		{name: "one file as argument", args: []string{"content/a/_index.md"}},
+		{name: "linked page excluded", excludes: []string{"b/", "c/"}},
+		{name: "broken link", args: []string{"content/c/_index.md"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targetPath, excludes, outputFormat = ".", tt.excludes, "stdout"
+			t.Cleanup(func() { excludes = nil })
+
+			err := runValidation(rootCmd, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runValidation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
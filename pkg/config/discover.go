@@ -0,0 +1,206 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/content"
+)
+
+// FileName is the name of the configuration files found by discovery
+const FileName = "frontmatter-validator.yaml"
+
+// Discovery lists the configuration files found for a target path
+type Discovery struct {
+	// Root is the repository root, the closest directory containing .git, or
+	// empty if the target path is not in a repository
+	Root string
+	// Files are the configuration files in the target directory and its
+	// parents up to Root, outermost first. The outermost applies to the whole
+	// run, the others like nested files.
+	Files []string
+	// Nested are the configuration files in subdirectories of the target
+	// directory, outermost first. Each applies to the files below its directory.
+	Nested []string
+}
+
+// Discover finds the configuration files for a target path, which is a
+// directory or a file, like a single page or an archive. It walks up from the
+// target directory, or the directory of the file, to the repository root, or
+// to the filesystem root outside of a repository. For a directory, it also
+// walks down through its subdirectories, skipping directories like
+// content.MarkdownFiles with the given options: hidden directories and those
+// ignored by git or excluded. File paths are relative to the working
+// directory where possible.
+func Discover(target string, opts ...content.Option) (Discovery, error) {
+	var discovery Discovery
+
+	start, err := filepath.Abs(target)
+	if err != nil {
+		return discovery, err
+	}
+	isDir := true
+	if info, err := os.Stat(start); err == nil && !info.IsDir() {
+		start = filepath.Dir(start)
+		isDir = false
+	}
+
+	for dir := start; ; dir = filepath.Dir(dir) {
+		if isFile(filepath.Join(dir, FileName)) {
+			discovery.Files = append([]string{displayPath(filepath.Join(dir, FileName))}, discovery.Files...)
+		}
+		if exists(filepath.Join(dir, ".git")) {
+			discovery.Root = dir
+			break
+		}
+		if dir == filepath.Dir(dir) {
+			break
+		}
+	}
+
+	// Configuration files next to a file don't apply to it
+	if !isDir {
+		return discovery, nil
+	}
+
+	// The walk starts at the repository root, so that its ignore files apply
+	base, walkRoot := start, "."
+	if discovery.Root != "" {
+		rel, err := filepath.Rel(discovery.Root, start)
+		if err != nil {
+			return discovery, err
+		}
+		base, walkRoot = discovery.Root, filepath.ToSlash(rel)
+	}
+	opts = append(opts, content.WithDiskRoot(base))
+	paths, err := content.Files(os.DirFS(base), walkRoot, func(name string) bool {
+		return path.Base(name) == FileName && path.Dir(name) != walkRoot
+	}, opts...)
+	if err != nil {
+		return discovery, err
+	}
+	for _, name := range paths {
+		discovery.Nested = append(discovery.Nested, displayPath(filepath.Join(base, filepath.FromSlash(name))))
+	}
+
+	// Parent directories sort before their subdirectories
+	sort.SliceStable(discovery.Nested, func(i, j int) bool {
+		return depth(discovery.Nested[i]) < depth(discovery.Nested[j])
+	})
+
+	return discovery, nil
+}
+
+// NewDiscoveredManager creates a configuration manager for discovered
+// configuration files. The outermost file applies to the whole run, with
+// patterns relative to its directory. Each other file, whether it was found in
+// a parent of the target directory or in a subdirectory, adds its
+// default_rules, directory_overrides and ignore_paths for the files below its
+// directory, with patterns relative to that directory. Other settings of these
+// files are not used. So the same file is read the same way, whichever
+// directory is validated.
+//
+// Without configuration files in the target directory or its parents, the
+// default configuration applies.
+func NewDiscoveredManager(discovery Discovery) (*Manager, error) {
+	manager := &Manager{nestedPaths: discovery.Nested}
+	if len(discovery.Files) > 0 {
+		manager.configPaths = discovery.Files[:1]
+		// Files in parents of the target directory are above the nested ones
+		manager.nestedPaths = append(append([]string{}, discovery.Files[1:]...), discovery.Nested...)
+	}
+
+	if err := manager.loadConfig(); err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	base := &scope{config: manager.config, sources: manager.sources}
+	if len(manager.configPaths) > 0 {
+		base.dir = absDir(manager.configPaths[0])
+	}
+	manager.scopes = []*scope{base}
+
+	for _, nestedPath := range manager.nestedPaths {
+		l := newLoader()
+		if err := l.load(nestedPath, ""); err != nil {
+			return nil, fmt.Errorf("failed to load configuration: %w", err)
+		}
+		manager.scopes = append(manager.scopes, &scope{dir: absDir(nestedPath), config: l.config, sources: l.sources})
+	}
+
+	return manager, nil
+}
+
+// scope is a configuration that applies to the files below a directory
+type scope struct {
+	// dir is the absolute directory that patterns are relative to, or empty
+	// to match patterns against file paths as given
+	dir     string
+	config  *Config
+	sources map[string]string
+}
+
+// relative returns a file path relative to the directory of the scope, and
+// whether the file is below that directory
+func (s *scope) relative(filePath string) (string, bool) {
+	if s.dir == "" {
+		return filePath, true
+	}
+	abs, err := filepath.Abs(filePath)
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(s.dir, abs)
+	if err != nil {
+		return "", false
+	}
+	rel = filepath.ToSlash(rel)
+	if rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", false
+	}
+	return rel, true
+}
+
+// displayPath returns a path relative to the working directory, or the
+// absolute path if that is not possible
+func displayPath(abs string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return abs
+	}
+	rel, err := filepath.Rel(wd, abs)
+	if err != nil {
+		return abs
+	}
+	return rel
+}
+
+// absDir returns the absolute directory of a file
+func absDir(filePath string) string {
+	abs, err := filepath.Abs(filePath)
+	if err != nil {
+		return filepath.Dir(filePath)
+	}
+	return filepath.Dir(abs)
+}
+
+// depth returns the number of path elements of a path
+func depth(path string) int {
+	return strings.Count(filepath.Clean(path), string(filepath.Separator))
+}
+
+// exists reports whether a file or directory exists
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// isFile reports whether a regular file exists
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
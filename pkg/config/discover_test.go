@@ -0,0 +1,265 @@
+package config
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/giantswarm/frontmatter-validator/internal/testutil"
+	"github.com/giantswarm/frontmatter-validator/pkg/content"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+func TestDiscover(t *testing.T) {
+	root := t.TempDir()
+	testutil.WriteFiles(t, root, map[string]string{
+		".git/HEAD":                                 "ref: refs/heads/main\n",
+		"frontmatter-validator.yaml":                "",
+		"docs/frontmatter-validator.yaml":           "",
+		"docs/api/frontmatter-validator.yaml":       "",
+		"docs/api/v1/frontmatter-validator.yaml":    "",
+		"docs/guides/page.md":                       "",
+		"docs/guides/v1/frontmatter-validator.yaml": "",
+		"docs/content.tar.gz":                       "",
+		"docs/.drafts/frontmatter-validator.yaml":   "",
+		"docs/.gitignore":                           "public/\n",
+		"docs/public/frontmatter-validator.yaml":    "",
+		"other/frontmatter-validator.yaml":          "",
+	})
+	t.Chdir(filepath.Join(root, "docs"))
+
+	tests := []struct {
+		name string
+		path string
+		opts []content.Option
+		want Discovery
+	}{
+		{
+			name: "working directory",
+			path: ".",
+			want: Discovery{
+				Root:   root,
+				Files:  []string{"../frontmatter-validator.yaml", "frontmatter-validator.yaml"},
+				Nested: []string{"api/frontmatter-validator.yaml", "api/v1/frontmatter-validator.yaml", "guides/v1/frontmatter-validator.yaml"},
+			},
+		},
+		{
+			name: "exclude",
+			path: ".",
+			opts: []content.Option{content.WithExclude("guides/")},
+			want: Discovery{
+				Root:   root,
+				Files:  []string{"../frontmatter-validator.yaml", "frontmatter-validator.yaml"},
+				Nested: []string{"api/frontmatter-validator.yaml", "api/v1/frontmatter-validator.yaml"},
+			},
+		},
+		{
+			name: "without gitignore",
+			path: ".",
+			opts: []content.Option{content.WithGitignore(false)},
+			want: Discovery{
+				Root:   root,
+				Files:  []string{"../frontmatter-validator.yaml", "frontmatter-validator.yaml"},
+				Nested: []string{"api/frontmatter-validator.yaml", "public/frontmatter-validator.yaml", "api/v1/frontmatter-validator.yaml", "guides/v1/frontmatter-validator.yaml"},
+			},
+		},
+		{
+			name: "subdirectory",
+			path: "api",
+			want: Discovery{
+				Root:   root,
+				Files:  []string{"../frontmatter-validator.yaml", "frontmatter-validator.yaml", "api/frontmatter-validator.yaml"},
+				Nested: []string{"api/v1/frontmatter-validator.yaml"},
+			},
+		},
+		{
+			name: "file",
+			path: "guides/page.md",
+			want: Discovery{
+				Root:  root,
+				Files: []string{"../frontmatter-validator.yaml", "frontmatter-validator.yaml"},
+			},
+		},
+		{
+			name: "archive",
+			path: "content.tar.gz",
+			want: Discovery{
+				Root:  root,
+				Files: []string{"../frontmatter-validator.yaml", "frontmatter-validator.yaml"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Discover(tt.path, tt.opts...)
+			if err != nil {
+				t.Fatalf("Discover() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Discover() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDiscoveredManager(t *testing.T) {
+	root := t.TempDir()
+	testutil.WriteFiles(t, root, map[string]string{
+		".git/HEAD": "ref: refs/heads/main\n",
+		"frontmatter-validator.yaml": `default_rules:
+  enabled_checks: [NO_TITLE, NO_DESCRIPTION]
+directory_overrides:
+  - path: "docs/api/**"
+    disabled_checks: [NO_DESCRIPTION]
+timezone: Europe/Berlin
+`,
+		"docs/api/frontmatter-validator.yaml": `default_rules:
+  enabled_checks: [NO_OWNER]
+directory_overrides:
+  - path: "v1/**"
+    disabled_checks: [NO_TITLE]
+ignore_paths:
+  - "drafts/**"
+timezone: UTC
+`,
+	})
+	// Run from a subdirectory, like an editor or pre-commit might
+	t.Chdir(filepath.Join(root, "docs"))
+
+	discovery, err := Discover(".")
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	manager, err := NewDiscoveredManager(discovery)
+	if err != nil {
+		t.Fatalf("NewDiscoveredManager() error = %v", err)
+	}
+
+	if got := manager.GetConfig().Timezone; got != "Europe/Berlin" {
+		t.Errorf("Timezone = %q, want the setting of the outermost file", got)
+	}
+
+	tests := []struct {
+		filePath    string
+		wantChecks  []string
+		wantIgnored bool
+	}{
+		{"guide.md", []string{"NO_TITLE", "NO_DESCRIPTION"}, false},
+		{"api/page.md", []string{"NO_TITLE", "NO_OWNER"}, false},
+		{"./api/v1/page.md", []string{"NO_OWNER"}, false},
+		{"api/drafts/page.md", []string{"NO_TITLE", "NO_OWNER"}, true},
+		{"drafts/page.md", []string{"NO_TITLE", "NO_DESCRIPTION"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filePath, func(t *testing.T) {
+			if got := manager.GetEnabledChecksForPath(tt.filePath); !reflect.DeepEqual(got, tt.wantChecks) {
+				t.Errorf("GetEnabledChecksForPath(%q) = %v, want %v", tt.filePath, got, tt.wantChecks)
+			}
+			if got := manager.IsPathIgnored(tt.filePath); got != tt.wantIgnored {
+				t.Errorf("IsPathIgnored(%q) = %v, want %v", tt.filePath, got, tt.wantIgnored)
+			}
+		})
+	}
+
+	resolution := manager.Resolve("api/v1/page.md")
+	wantOverrides := []OverrideMatch{
+		{Index: 0, Path: "docs/api/**", File: "../frontmatter-validator.yaml"},
+		{Index: 0, Path: "v1/**", File: "api/frontmatter-validator.yaml"},
+	}
+	if !reflect.DeepEqual(resolution.MatchedOverrides, wantOverrides) {
+		t.Errorf("MatchedOverrides = %+v, want %+v", resolution.MatchedOverrides, wantOverrides)
+	}
+}
+
+func TestNewDiscoveredManager_Defaults(t *testing.T) {
+	root := t.TempDir()
+	testutil.WriteFiles(t, root, map[string]string{
+		".git/HEAD":          "ref: refs/heads/main\n",
+		"src/content/a.md":   "",
+		"src/content/b/c.md": "",
+	})
+	t.Chdir(filepath.Join(root, "src", "content"))
+
+	discovery, err := Discover(".")
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if len(discovery.Files) != 0 || len(discovery.Nested) != 0 {
+		t.Fatalf("Discover() = %+v, want no files", discovery)
+	}
+	manager, err := NewDiscoveredManager(discovery)
+	if err != nil {
+		t.Fatalf("NewDiscoveredManager() error = %v", err)
+	}
+
+	if got, want := manager.GetEnabledChecksForPath("b/c.md"), validator.DefaultEnabledChecks(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetEnabledChecksForPath() = %v, want the default profile %v", got, want)
+	}
+}
+
+func TestNewDiscoveredManager_PathIndependent(t *testing.T) {
+	root := t.TempDir()
+	testutil.WriteFiles(t, root, map[string]string{
+		".git/HEAD": "ref: refs/heads/main\n",
+		"frontmatter-validator.yaml": `default_rules:
+  enabled_checks: [NO_TITLE, NO_DESCRIPTION]
+timezone: Europe/Berlin
+`,
+		"docs/frontmatter-validator.yaml": `default_rules:
+  enabled_checks: [NO_OWNER]
+directory_overrides:
+  - path: "api/**"
+    disabled_checks: [NO_TITLE]
+ignore_paths:
+  - "drafts/**"
+timezone: UTC
+`,
+		"docs/api/page.md": "",
+	})
+	t.Chdir(root)
+
+	// The file in docs is a parent of the target with --path=docs/api and a
+	// nested file with --path=., and must be read the same way for both
+	files := []string{"docs/api/page.md", "docs/drafts/page.md", "docs/guide.md"}
+	type result struct {
+		checks   []string
+		ignored  bool
+		timezone string
+	}
+	var results []map[string]result
+	for _, path := range []string{".", "docs/api"} {
+		discovery, err := Discover(path)
+		if err != nil {
+			t.Fatalf("Discover(%q) error = %v", path, err)
+		}
+		manager, err := NewDiscoveredManager(discovery)
+		if err != nil {
+			t.Fatalf("NewDiscoveredManager(%q) error = %v", path, err)
+		}
+		if got, want := manager.GetConfigPaths(), []string{"frontmatter-validator.yaml"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("--path=%s: GetConfigPaths() = %v, want %v", path, got, want)
+		}
+
+		got := make(map[string]result)
+		for _, filePath := range files {
+			got[filePath] = result{
+				checks:   manager.GetEnabledChecksForPath(filePath),
+				ignored:  manager.IsPathIgnored(filePath),
+				timezone: manager.GetConfig().Timezone,
+			}
+		}
+		results = append(results, got)
+	}
+
+	want := map[string]result{
+		"docs/api/page.md":    {checks: []string{"NO_DESCRIPTION", "NO_OWNER"}, timezone: "Europe/Berlin"},
+		"docs/drafts/page.md": {checks: []string{"NO_TITLE", "NO_DESCRIPTION", "NO_OWNER"}, ignored: true, timezone: "Europe/Berlin"},
+		"docs/guide.md":       {checks: []string{"NO_TITLE", "NO_DESCRIPTION", "NO_OWNER"}, timezone: "Europe/Berlin"},
+	}
+	for i, path := range []string{".", "docs/api"} {
+		if !reflect.DeepEqual(results[i], want) {
+			t.Errorf("--path=%s: results = %+v, want %+v", path, results[i], want)
+		}
+	}
+}
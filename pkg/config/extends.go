@@ -0,0 +1,271 @@
+package config
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+)
+
+// BuiltinPrefix marks references to built-in presets, like "builtin:last-reviewed"
+const BuiltinPrefix = "builtin:"
+
+// DefaultsSource is the source of settings from the built-in default configuration
+const DefaultsSource = "built-in defaults"
+
+// InlineSource is the source of settings from a configuration passed as bytes
+// or struct instead of a file
+const InlineSource = "inline configuration"
+
+//go:embed presets/*.yaml
+var presets embed.FS
+
+// Presets returns the names of the built-in presets, without BuiltinPrefix.
+// Internal presets, whose name starts with "_", only serve to be extended by
+// other presets and are not listed.
+func Presets() []string {
+	entries, _ := presets.ReadDir("presets")
+	var names []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "_") {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(entry.Name(), ".yaml"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loader reads configuration layers and merges them into a single
+// configuration, recording the source of each setting
+type loader struct {
+	config  *Config
+	sources map[string]string
+	// stack holds the layers being loaded, to detect cycles
+	stack []string
+	// loaded holds the layers merged so far, which are only applied once
+	loaded map[string]bool
+}
+
+func newLoader() *loader {
+	return &loader{config: &Config{}, sources: make(map[string]string), loaded: make(map[string]bool)}
+}
+
+// load reads a configuration file or preset and the configurations it
+// extends, and merges them in order: extended configurations first, in the
+// order given, then the layer itself. A layer that was already merged, for
+// example because two layers extend it, is skipped. from is the name of the
+// extending layer, or empty for files given on the command line.
+func (l *loader) load(ref, from string) error {
+	data, name, err := readLayer(ref, from)
+	if err != nil {
+		return err
+	}
+	return l.loadData(data, name)
+}
+
+// loadData merges a configuration layer given as YAML and the configurations
+// it extends
+func (l *loader) loadData(data []byte, name string) error {
+	for _, loading := range l.stack {
+		if loading == name {
+			return fmt.Errorf("configuration %s extends itself: %s -> %s", name, strings.Join(l.stack, " -> "), name)
+		}
+	}
+	if l.loaded[name] {
+		return nil
+	}
+	var layer Config
+	if err := yaml.Unmarshal(data, &layer); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", name, err)
+	}
+	// Decode once more to tell settings that are set to their zero value
+	// from settings that are not set at all
+	keys := make(map[string]any)
+	if err := yaml.Unmarshal(data, &keys); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", name, err)
+	}
+
+	return l.apply(&layer, keys, name)
+}
+
+// apply merges a parsed configuration layer after the configurations it
+// extends. keys holds the top-level keys set in the layer.
+func (l *loader) apply(layer *Config, keys map[string]any, name string) error {
+	l.stack = append(l.stack, name)
+	defer func() { l.stack = l.stack[:len(l.stack)-1] }()
+
+	for _, parent := range layer.Extends {
+		if err := l.load(parent, name); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	l.merge(layer, keys, name)
+	l.loaded[name] = true
+	return nil
+}
+
+// readLayer returns the content of a configuration file or preset, and its
+// name for messages and sources. Local paths are relative to the directory of
+// the extending file, and presets can only extend other presets.
+func readLayer(ref, from string) ([]byte, string, error) {
+	if name, ok := strings.CutPrefix(ref, BuiltinPrefix); ok {
+		data, err := presets.ReadFile(path.Join("presets", name+".yaml"))
+		if err != nil {
+			return nil, "", fmt.Errorf("unknown preset %q, available presets: %s%s", ref, BuiltinPrefix, strings.Join(Presets(), ", "+BuiltinPrefix))
+		}
+		return data, ref, nil
+	}
+
+	if strings.HasPrefix(from, BuiltinPrefix) {
+		return nil, "", fmt.Errorf("presets can only extend other presets, not %q", ref)
+	}
+
+	filePath := ref
+	if from != "" && !filepath.IsAbs(ref) {
+		filePath = filepath.Join(filepath.Dir(from), ref)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read config file %s: %w", filePath, err)
+	}
+	return data, filePath, nil
+}
+
+// merge applies a layer on top of the configuration loaded so far. keys holds
+// the top-level keys set in the layer.
+//
+// For checks in default_rules, the last layer mentioning a check decides
+// whether it is enabled, and within a layer, disabled_checks wins. Directory
+// overrides, ignore paths and owner rules are appended, with a later owner
+// rule replacing an earlier one for the same owner. Other settings replace
+// earlier values when they are set.
+func (l *loader) merge(layer *Config, keys map[string]any, name string) {
+	config := l.config
+	isSet := func(key string) bool {
+		_, ok := keys[key]
+		return ok
+	}
+
+	for _, check := range layer.DefaultRules.EnabledChecks {
+		config.DefaultRules.DisabledChecks = remove(config.DefaultRules.DisabledChecks, check)
+		config.DefaultRules.EnabledChecks = appendNew(config.DefaultRules.EnabledChecks, check)
+		l.sources["default_rules.checks."+check] = name
+	}
+	for _, check := range layer.DefaultRules.DisabledChecks {
+		config.DefaultRules.EnabledChecks = remove(config.DefaultRules.EnabledChecks, check)
+		config.DefaultRules.DisabledChecks = appendNew(config.DefaultRules.DisabledChecks, check)
+		l.sources["default_rules.checks."+check] = name
+	}
+
+	for _, override := range layer.DirectoryOverrides {
+		l.sources[fmt.Sprintf("directory_overrides[%d]", len(config.DirectoryOverrides))] = name
+		config.DirectoryOverrides = append(config.DirectoryOverrides, override)
+	}
+
+	for _, pattern := range layer.IgnorePaths {
+		if !contains(config.IgnorePaths, pattern) {
+			l.sources[fmt.Sprintf("ignore_paths[%d]", len(config.IgnorePaths))] = name
+			config.IgnorePaths = append(config.IgnorePaths, pattern)
+		}
+	}
+
+	if layer.ContentExtensions != nil {
+		config.ContentExtensions = layer.ContentExtensions
+		l.sources["content_extensions"] = name
+	}
+	if isSet("timezone") {
+		config.Timezone = layer.Timezone
+		l.sources["timezone"] = name
+	}
+	if isSet("strict_dates") {
+		config.StrictDates = layer.StrictDates
+		l.sources["strict_dates"] = name
+	}
+
+	setInt := func(key string, target **int, value *int) {
+		if value != nil {
+			*target = value
+			l.sources[key] = name
+		}
+	}
+	setInt("thresholds.expiration_in_days.min", &config.Thresholds.ExpirationInDays.Min, layer.Thresholds.ExpirationInDays.Min)
+	setInt("thresholds.expiration_in_days.max", &config.Thresholds.ExpirationInDays.Max, layer.Thresholds.ExpirationInDays.Max)
+	setInt("thresholds.weight.min", &config.Thresholds.Weight.Min, layer.Thresholds.Weight.Min)
+	setInt("thresholds.weight.max", &config.Thresholds.Weight.Max, layer.Thresholds.Weight.Max)
+
+	setString := func(key string, target *string, value string) {
+		if value != "" {
+			*target = value
+			l.sources[key] = name
+		}
+	}
+	setString("review_issues.link_base", &config.ReviewIssues.LinkBase, layer.ReviewIssues.LinkBase)
+	setString("review_issues.title", &config.ReviewIssues.Title, layer.ReviewIssues.Title)
+	setString("review_issues.message", &config.ReviewIssues.Message, layer.ReviewIssues.Message)
+
+	for _, rule := range layer.OwnerMapping.Owners {
+		l.sources["owner_mapping.owners."+rule.Owner] = name
+		replaced := false
+		for i := range config.OwnerMapping.Owners {
+			if config.OwnerMapping.Owners[i].Owner == rule.Owner {
+				config.OwnerMapping.Owners[i] = rule
+				replaced = true
+			}
+		}
+		if !replaced {
+			config.OwnerMapping.Owners = append(config.OwnerMapping.Owners, rule)
+		}
+	}
+
+	fallback := layer.OwnerMapping.Fallback
+	if fallback.DeriveLabels != nil {
+		config.OwnerMapping.Fallback.DeriveLabels = fallback.DeriveLabels
+		l.sources["owner_mapping.fallback.derive_labels"] = name
+	}
+	setList := func(key string, target *[]string, value []string) {
+		if value != nil {
+			*target = value
+			l.sources[key] = name
+		}
+	}
+	setList("owner_mapping.fallback.labels", &config.OwnerMapping.Fallback.Labels, fallback.Labels)
+	setList("owner_mapping.fallback.assignees", &config.OwnerMapping.Fallback.Assignees, fallback.Assignees)
+	setList("owner_mapping.fallback.projects", &config.OwnerMapping.Fallback.Projects, fallback.Projects)
+}
+
+// contains reports whether a list contains a value
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// appendNew appends a value unless the list already contains it
+func appendNew(list []string, value string) []string {
+	if contains(list, value) {
+		return list
+	}
+	return append(list, value)
+}
+
+// remove returns the list without the given value
+func remove(list []string, value string) []string {
+	result := list[:0]
+	for _, v := range list {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,214 @@
+package config
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/giantswarm/frontmatter-validator/internal/testutil"
+)
+
+func TestNewManager_Extends(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFiles(t, dir, map[string]string{
+		"shared/base.yaml": `default_rules:
+  enabled_checks: [NO_TITLE, NO_OWNER, NO_WEIGHT]
+  disabled_checks: [NO_DESCRIPTION]
+directory_overrides:
+  - path: src/content/vintage/**
+    disabled_checks: [NO_OWNER]
+ignore_paths: [src/content/archive/**]
+content_extensions: [.md, .markdown]
+timezone: Europe/Berlin
+strict_dates: true
+thresholds:
+  weight:
+    min: 0
+    max: 100
+`,
+		"repo.yaml": `extends: shared/base.yaml
+default_rules:
+  enabled_checks: [NO_DESCRIPTION]
+  disabled_checks: [NO_WEIGHT]
+directory_overrides:
+  - path: src/content/changes/**
+    disabled_checks: [NO_TITLE]
+ignore_paths: [src/content/archive/**, src/content/drafts/**]
+content_extensions: [.md, .mdx]
+strict_dates: false
+thresholds:
+  weight:
+    max: 1000
+`,
+	})
+	repo := filepath.Join(dir, "repo.yaml")
+	base := filepath.Join(dir, "shared", "base.yaml")
+
+	manager, err := NewManager(repo)
+	if err != nil {
+		t.Fatalf("NewManager() error = %v", err)
+	}
+	cfg := manager.GetConfig()
+
+	if want := []string{"NO_TITLE", "NO_OWNER", "NO_DESCRIPTION"}; !reflect.DeepEqual(cfg.DefaultRules.EnabledChecks, want) {
+		t.Errorf("EnabledChecks = %v, want %v", cfg.DefaultRules.EnabledChecks, want)
+	}
+	if want := []string{"NO_WEIGHT"}; !reflect.DeepEqual(cfg.DefaultRules.DisabledChecks, want) {
+		t.Errorf("DisabledChecks = %v, want %v", cfg.DefaultRules.DisabledChecks, want)
+	}
+	if len(cfg.DirectoryOverrides) != 2 || cfg.DirectoryOverrides[0].Path != "src/content/vintage/**" || cfg.DirectoryOverrides[1].Path != "src/content/changes/**" {
+		t.Errorf("DirectoryOverrides = %+v, want the base override followed by the repo override", cfg.DirectoryOverrides)
+	}
+	if want := []string{"src/content/archive/**", "src/content/drafts/**"}; !reflect.DeepEqual(cfg.IgnorePaths, want) {
+		t.Errorf("IgnorePaths = %v, want %v", cfg.IgnorePaths, want)
+	}
+	if want := []string{".md", ".mdx"}; !reflect.DeepEqual(cfg.ContentExtensions, want) {
+		t.Errorf("ContentExtensions = %v, want the list of the extending file %v", cfg.ContentExtensions, want)
+	}
+	if cfg.Timezone != "Europe/Berlin" {
+		t.Errorf("Timezone = %q, want the inherited value", cfg.Timezone)
+	}
+	if cfg.StrictDates {
+		t.Errorf("StrictDates = true, want it turned off by the extending file")
+	}
+	if *cfg.Thresholds.Weight.Min != 0 || *cfg.Thresholds.Weight.Max != 1000 {
+		t.Errorf("Thresholds.Weight = %d..%d, want 0..1000", *cfg.Thresholds.Weight.Min, *cfg.Thresholds.Weight.Max)
+	}
+
+	sources := []struct {
+		setting string
+		want    string
+	}{
+		{"default_rules.checks.NO_TITLE", base},
+		{"default_rules.checks.NO_WEIGHT", repo},
+		{"default_rules.checks.NO_DESCRIPTION", repo},
+		{"directory_overrides[0]", base},
+		{"directory_overrides[1]", repo},
+		{"ignore_paths[1]", repo},
+		{"content_extensions", repo},
+		{"timezone", base},
+		{"strict_dates", repo},
+		{"thresholds.weight.min", base},
+		{"thresholds.weight.max", repo},
+		{"review_issues.title", ""},
+	}
+	for _, tt := range sources {
+		if got := manager.Source(tt.setting); got != tt.want {
+			t.Errorf("Source(%q) = %q, want %q", tt.setting, got, tt.want)
+		}
+	}
+}
+
+func TestNewManager_LayeredConfigs(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFiles(t, dir, map[string]string{
+		"a.yaml": "default_rules:\n  enabled_checks: [NO_TITLE, NO_OWNER]\n",
+		"b.yaml": "default_rules:\n  disabled_checks: [NO_OWNER]\n",
+	})
+
+	manager, err := NewManager(filepath.Join(dir, "a.yaml"), filepath.Join(dir, "b.yaml"))
+	if err != nil {
+		t.Fatalf("NewManager() error = %v", err)
+	}
+
+	got := manager.GetEnabledChecksForPath("src/content/page.md")
+	if want := []string{"NO_TITLE"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetEnabledChecksForPath() = %v, want %v", got, want)
+	}
+	if got, want := manager.Source("default_rules.checks.NO_OWNER"), filepath.Join(dir, "b.yaml"); got != want {
+		t.Errorf("Source() = %q, want %q", got, want)
+	}
+}
+
+func TestNewManager_ExtendsOnce(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFiles(t, dir, map[string]string{
+		"base.yaml": "directory_overrides:\n  - path: src/content/vintage/**\n    disabled_checks: [NO_OWNER]\n",
+		"a.yaml":    "extends: base.yaml\n",
+		"b.yaml":    "extends: [base.yaml, a.yaml]\n",
+	})
+
+	manager, err := NewManager(filepath.Join(dir, "b.yaml"))
+	if err != nil {
+		t.Fatalf("NewManager() error = %v", err)
+	}
+	if n := len(manager.GetConfig().DirectoryOverrides); n != 1 {
+		t.Errorf("got %d directory overrides, want the base configuration applied once", n)
+	}
+}
+
+func TestNewManager_ExtendsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name: "cycle",
+			files: map[string]string{
+				"config.yaml": "extends: a.yaml\n",
+				"a.yaml":      "extends: config.yaml\n",
+			},
+			wantErr: "extends itself",
+		},
+		{
+			name:    "missing file",
+			files:   map[string]string{"config.yaml": "extends: missing.yaml\n"},
+			wantErr: "failed to read config file",
+		},
+		{
+			name:    "unknown preset",
+			files:   map[string]string{"config.yaml": "extends: builtin:nope\n"},
+			wantErr: `unknown preset "builtin:nope", available presets: builtin:giantswarm-docs, builtin:last-reviewed`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			testutil.WriteFiles(t, dir, tt.files)
+			_, err := NewManager(filepath.Join(dir, "config.yaml"))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewManager() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPresets(t *testing.T) {
+	// The example configuration is the giantswarm-docs preset
+	l := newLoader()
+	if err := l.load(BuiltinPrefix+"giantswarm-docs", ""); err != nil {
+		t.Fatalf("loading preset failed: %v", err)
+	}
+	example, err := NewManager("../../frontmatter-validator.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(l.config, example.GetConfig()) {
+		t.Errorf("builtin:giantswarm-docs differs from frontmatter-validator.yaml")
+	}
+
+	// The last-reviewed preset applies the shared overrides and its own
+	lastReviewed, err := NewManagerFromBytes([]byte("extends: builtin:last-reviewed\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, filePath := range []string{"src/content/vintage/page.md", "src/content/changes/page.md", "src/content/meta/page.md"} {
+		if checks := lastReviewed.GetEnabledChecksForPath(filePath); containsCheck(checks, "NO_LAST_REVIEW_DATE") {
+			t.Errorf("builtin:last-reviewed enables NO_LAST_REVIEW_DATE for %s", filePath)
+		}
+	}
+	if checks := lastReviewed.GetEnabledChecksForPath("src/content/page.md"); !containsCheck(checks, "NO_LAST_REVIEW_DATE") || containsCheck(checks, "NO_TITLE") {
+		t.Errorf("builtin:last-reviewed enabled checks = %v, want only the review date checks", checks)
+	}
+
+	for _, name := range Presets() {
+		dir := t.TempDir()
+		testutil.WriteFiles(t, dir, map[string]string{"config.yaml": "extends: " + BuiltinPrefix + name + "\n"})
+		if _, err := NewManager(filepath.Join(dir, "config.yaml")); err != nil {
+			t.Errorf("extending preset %s failed: %v", name, err)
+		}
+	}
+}
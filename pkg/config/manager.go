@@ -5,19 +5,28 @@ import (
 	"os"
 	"strings"
 
-	"go.yaml.in/yaml/v4"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
 )
 
 // Manager handles loading and resolving configuration
 type Manager struct {
-	config     *Config
-	configPath string
+	config      *Config
+	configPaths []string
+	// sources maps settings to the configuration file or preset they come from
+	sources map[string]string
+	// scopes hold the configurations applying to files, outermost first. If
+	// empty, config applies to all files.
+	scopes      []*scope
+	nestedPaths []string
 }
 
-// NewManager creates a new configuration manager
-func NewManager(configPath string) (*Manager, error) {
+// NewManager creates a new configuration manager. Several configuration files
+// are layered in the given order, with later files taking precedence. Without
+// configuration files, the default configuration is used. A configuration file
+// that doesn't exist is an error.
+func NewManager(configPaths ...string) (*Manager, error) {
 	manager := &Manager{
-		configPath: configPath,
+		configPaths: configPaths,
 	}
 
 	if err := manager.loadConfig(); err != nil {
@@ -27,71 +36,177 @@ func NewManager(configPath string) (*Manager, error) {
 	return manager, nil
 }
 
-// loadConfig loads the configuration from the specified file
-func (m *Manager) loadConfig() error {
-	// Check if config file exists
-	if _, err := os.Stat(m.configPath); os.IsNotExist(err) {
-		// If no config file exists, use default configuration
-		m.config = m.getDefaultConfig()
-		return nil
+// NewManagerFromBytes creates a configuration manager from the content of a
+// configuration file. Local paths in extends are relative to the working
+// directory.
+func NewManagerFromBytes(data []byte) (*Manager, error) {
+	l := newLoader()
+	if err := l.loadData(data, InlineSource); err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+	return &Manager{config: l.config, sources: l.sources}, nil
+}
+
+// NewManagerFromConfig creates a configuration manager from a configuration
+// struct. Extends is applied like in configuration files, with local paths
+// relative to the working directory. As a struct can't tell unset fields from
+// zero values, Timezone and StrictDates only override extended configurations
+// when they are not empty and true.
+func NewManagerFromConfig(cfg *Config) (*Manager, error) {
+	keys := make(map[string]any)
+	if cfg.Timezone != "" {
+		keys["timezone"] = cfg.Timezone
+	}
+	if cfg.StrictDates {
+		keys["strict_dates"] = cfg.StrictDates
+	}
+
+	l := newLoader()
+	if err := l.apply(cfg, keys, InlineSource); err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
 	}
+	return &Manager{config: l.config, sources: l.sources}, nil
+}
 
-	// Read config file
-	data, err := os.ReadFile(m.configPath)
-	if err != nil {
-		return fmt.Errorf("failed to read config file %s: %w", m.configPath, err)
+// loadConfig loads and merges the configuration files and the configurations
+// they extend
+func (m *Manager) loadConfig() error {
+	l := newLoader()
+
+	for _, configPath := range m.configPaths {
+		if _, err := os.Stat(configPath); os.IsNotExist(err) {
+			return fmt.Errorf("configuration file %s not found", configPath)
+		}
+		if err := l.load(configPath, ""); err != nil {
+			return err
+		}
 	}
 
-	// Parse YAML
-	var config Config
-	if err := yaml.Unmarshal(data, &config); err != nil {
-		return fmt.Errorf("failed to parse config file %s: %w", m.configPath, err)
+	if len(l.loaded) == 0 {
+		// Without config files, use default configuration
+		l.merge(m.getDefaultConfig(), map[string]any{}, DefaultsSource)
 	}
 
-	m.config = &config
+	m.config = l.config
+	m.sources = l.sources
 	return nil
 }
 
 // GetEnabledChecksForPath returns the list of enabled checks for a given file path
 func (m *Manager) GetEnabledChecksForPath(filePath string) []string {
-	if m.config == nil {
-		return []string{}
+	var result []string
+	for _, decision := range m.Resolve(filePath).Checks {
+		if decision.Enabled {
+			result = append(result, decision.Check)
+		}
 	}
 
-	// Start with default enabled checks
-	enabled := make(map[string]bool)
-	for _, check := range m.config.DefaultRules.EnabledChecks {
-		enabled[check] = true
-	}
+	return result
+}
+
+// CheckDecision records whether a check is enabled for a path, and the
+// configuration entry that made the final decision
+type CheckDecision struct {
+	Check   string `json:"check"`
+	Enabled bool   `json:"enabled"`
+	Source  string `json:"source"`         // Like "default_rules.enabled_checks"
+	File    string `json:"file,omitempty"` // Configuration file or preset of the entry
+}
 
-	// Apply default disabled checks
-	for _, check := range m.config.DefaultRules.DisabledChecks {
-		enabled[check] = false
+// OverrideMatch is a directory override matching a path
+type OverrideMatch struct {
+	Index int    `json:"index"`          // Index in the directory_overrides of the configuration
+	Path  string `json:"path"`           // Pattern of the override
+	File  string `json:"file,omitempty"` // Configuration file or preset of the override
+}
+
+// Resolution describes how the configuration applies to a file path
+type Resolution struct {
+	Path string `json:"path"`
+	// IgnoredBy is the ignore_paths pattern matching the path, if any
+	IgnoredBy string `json:"ignored_by,omitempty"`
+	// MatchedOverrides holds the matching directory overrides
+	MatchedOverrides []OverrideMatch `json:"matched_overrides"`
+	// Checks holds a decision for every check named in the configuration, in
+	// order of first mention
+	Checks []CheckDecision `json:"checks"`
+}
+
+// Resolve applies the default rules and the matching directory overrides, in
+// order, to a file path. With nested configuration files, the rules of each
+// configuration whose directory contains the path are applied in turn, the
+// outermost first.
+func (m *Manager) Resolve(filePath string) Resolution {
+	resolution := Resolution{Path: filePath, MatchedOverrides: []OverrideMatch{}}
+
+	decisions := make(map[string]int)
+	decide := func(checks []string, enabled bool, source, file string, sources map[string]string) {
+		for _, check := range checks {
+			decision := CheckDecision{Check: check, Enabled: enabled, Source: source, File: file}
+			if file == "" {
+				decision.File = sources["default_rules.checks."+check]
+			}
+			if i, ok := decisions[check]; ok {
+				resolution.Checks[i] = decision
+				continue
+			}
+			decisions[check] = len(resolution.Checks)
+			resolution.Checks = append(resolution.Checks, decision)
+		}
 	}
 
-	// Apply directory overrides in order
-	for _, override := range m.config.DirectoryOverrides {
-		if m.pathMatches(filePath, override.Path) {
-			// Enable specified checks
-			for _, check := range override.EnabledChecks {
-				enabled[check] = true
+	for _, s := range m.scopesFor(filePath) {
+		path, ok := s.relative(filePath)
+		if !ok {
+			path = filePath
+		}
+
+		if resolution.IgnoredBy == "" {
+			for _, pattern := range s.config.IgnorePaths {
+				if m.pathMatches(path, pattern) {
+					resolution.IgnoredBy = pattern
+					break
+				}
 			}
-			// Disable specified checks
-			for _, check := range override.DisabledChecks {
-				enabled[check] = false
+		}
+
+		// Start with the default rules, where disabled checks win
+		decide(s.config.DefaultRules.EnabledChecks, true, "default_rules.enabled_checks", "", s.sources)
+		decide(s.config.DefaultRules.DisabledChecks, false, "default_rules.disabled_checks", "", s.sources)
+
+		// Apply directory overrides in order
+		for i, override := range s.config.DirectoryOverrides {
+			if m.pathMatches(path, override.Path) {
+				file := s.sources[fmt.Sprintf("directory_overrides[%d]", i)]
+				resolution.MatchedOverrides = append(resolution.MatchedOverrides, OverrideMatch{Index: i, Path: override.Path, File: file})
+				decide(override.EnabledChecks, true, fmt.Sprintf("directory_overrides[%d].enabled_checks (%s)", i, override.Path), file, s.sources)
+				decide(override.DisabledChecks, false, fmt.Sprintf("directory_overrides[%d].disabled_checks (%s)", i, override.Path), file, s.sources)
 			}
 		}
 	}
 
-	// Convert back to slice
-	var result []string
-	for check, isEnabled := range enabled {
-		if isEnabled {
-			result = append(result, check)
+	return resolution
+}
+
+// scopesFor returns the configurations that apply to a file path, outermost
+// first
+func (m *Manager) scopesFor(filePath string) []*scope {
+	if len(m.scopes) == 0 {
+		if m.config == nil {
+			return nil
 		}
+		return []*scope{{config: m.config, sources: m.sources}}
 	}
 
-	return result
+	// The outermost configuration applies to all files, and nested ones only
+	// to the files below their directory
+	scopes := []*scope{m.scopes[0]}
+	for _, s := range m.scopes[1:] {
+		if _, ok := s.relative(filePath); ok {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
 }
 
 // pathMatches checks if a file path matches a glob pattern
@@ -118,110 +233,27 @@ func (m *Manager) pathMatches(filePath, pattern string) bool {
 	return filePath == pattern
 }
 
-// getDefaultConfig returns the default configuration that matches current hardcoded behavior
+// getDefaultConfig returns the default profile, the same checks that
+// validator.New enables
 func (m *Manager) getDefaultConfig() *Config {
 	return &Config{
 		DefaultRules: RuleSet{
-			EnabledChecks: []string{
-				"NO_FRONT_MATTER",
-				"NO_TRAILING_NEWLINE",
-				"UNKNOWN_ATTRIBUTE",
-				"NO_TITLE",
-				"LONG_TITLE",
-				"SHORT_TITLE",
-				"NO_DESCRIPTION",
-				"LONG_DESCRIPTION",
-				"SHORT_DESCRIPTION",
-				"NO_FULL_STOP_DESCRIPTION",
-				"INVALID_DESCRIPTION",
-				"NO_LINK_TITLE",
-				"LONG_LINK_TITLE",
-				"NO_WEIGHT",
-				"NO_OWNER",
-				"INVALID_OWNER",
-				"NO_LAST_REVIEW_DATE",
-				"REVIEW_TOO_LONG_AGO",
-				"INVALID_LAST_REVIEW_DATE",
-				"NO_USER_QUESTIONS",
-				"LONG_USER_QUESTION",
-				"NO_QUESTION_MARK",
-				// INVALID_DIATAXIS_CONTENT_TYPE is safe to enable by default (only fires on a
-				// bad value). NO_DIATAXIS_CONTENT_TYPE is opt-in — enable it once pages are tagged.
-				"INVALID_DIATAXIS_CONTENT_TYPE",
-			},
-		},
-		DirectoryOverrides: []DirectoryOverride{
-			{
-				Path: "src/content/reference/platform-api/crd/**",
-				DisabledChecks: []string{
-					"NO_DESCRIPTION",
-					"LONG_DESCRIPTION",
-					"SHORT_DESCRIPTION",
-					"NO_FULL_STOP_DESCRIPTION",
-					"INVALID_DESCRIPTION",
-					"NO_LINK_TITLE",
-					"NO_OWNER",
-					"NO_USER_QUESTIONS",
-				},
-			},
-			{
-				Path: "src/content/vintage/use-the-api/management-api/crd/**",
-				DisabledChecks: []string{
-					"NO_DESCRIPTION",
-					"LONG_DESCRIPTION",
-					"SHORT_DESCRIPTION",
-					"NO_FULL_STOP_DESCRIPTION",
-					"INVALID_DESCRIPTION",
-					"NO_LINK_TITLE",
-					"NO_OWNER",
-					"NO_USER_QUESTIONS",
-				},
-			},
-			{
-				Path: "src/content/changes/**",
-				DisabledChecks: []string{
-					"NO_DESCRIPTION",
-					"SHORT_DESCRIPTION",
-					"NO_FULL_STOP_DESCRIPTION",
-					"NO_LINK_TITLE",
-					"LONG_LINK_TITLE",
-					"NO_OWNER",
-					"NO_USER_QUESTIONS",
-				},
-			},
-			{
-				Path: "src/content/vintage/**",
-				DisabledChecks: []string{
-					"NO_LAST_REVIEW_DATE",
-					"REVIEW_TOO_LONG_AGO",
-				},
-			},
-			{
-				Path: "src/content/reference/platform-api/cluster-apps/**",
-				DisabledChecks: []string{
-					"NO_LAST_REVIEW_DATE",
-					"REVIEW_TOO_LONG_AGO",
-				},
-			},
-			{
-				Path: "src/content/meta/**",
-				DisabledChecks: []string{
-					"NO_LAST_REVIEW_DATE",
-				},
-			},
+			EnabledChecks: validator.DefaultEnabledChecks(),
 		},
 	}
 }
 
 // IsPathIgnored checks if a file path should be completely ignored based on ignore_paths configuration
 func (m *Manager) IsPathIgnored(filePath string) bool {
-	if m.config == nil {
-		return false
-	}
-
-	for _, pattern := range m.config.IgnorePaths {
-		if m.pathMatches(filePath, pattern) {
-			return true
+	for _, s := range m.scopesFor(filePath) {
+		path, ok := s.relative(filePath)
+		if !ok {
+			path = filePath
+		}
+		for _, pattern := range s.config.IgnorePaths {
+			if m.pathMatches(path, pattern) {
+				return true
+			}
 		}
 	}
 
@@ -233,7 +265,41 @@ func (m *Manager) GetConfig() *Config {
 	return m.config
 }
 
-// GetConfigPath returns the path to the configuration file
+// GetConfigPath returns the path to the last configuration file
 func (m *Manager) GetConfigPath() string {
-	return m.configPath
+	if len(m.configPaths) == 0 {
+		return ""
+	}
+	return m.configPaths[len(m.configPaths)-1]
+}
+
+// GetConfigPaths returns the paths to all configuration files, in the order
+// they are layered
+func (m *Manager) GetConfigPaths() []string {
+	return m.configPaths
+}
+
+// GetNestedConfigPaths returns the paths to the discovered configuration files
+// that apply to a subtree, outermost first
+func (m *Manager) GetNestedConfigPaths() []string {
+	return m.nestedPaths
+}
+
+// Source returns the configuration file or preset a setting comes from, or
+// DefaultsSource for the built-in default configuration. Settings are named
+// like "timezone", "thresholds.weight.max", "review_issues.title",
+// "default_rules.checks.NO_TITLE", "directory_overrides[2]", "ignore_paths[0]",
+// "owner_mapping.owners.<owner>" or "owner_mapping.fallback.labels". It returns
+// an empty string for settings that are not set.
+func (m *Manager) Source(setting string) string {
+	return m.sources[setting]
+}
+
+// Sources returns the source of every setting, as described for Source
+func (m *Manager) Sources() map[string]string {
+	sources := make(map[string]string, len(m.sources))
+	for setting, source := range m.sources {
+		sources[setting] = source
+	}
+	return sources
 }
@@ -3,7 +3,12 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
 )
 
 func TestManager_GetEnabledChecksForPath(t *testing.T) {
@@ -290,13 +295,12 @@ func TestManager_IsPathIgnored(t *testing.T) {
 }
 
 func TestNewManager_NoConfigFile(t *testing.T) {
-	// Test with non-existent config file
-	manager, err := NewManager("/non/existent/config.yaml")
+	// Without config files, the default config is used
+	manager, err := NewManager()
 	if err != nil {
-		t.Fatalf("NewManager() should not error when config file doesn't exist, got: %v", err)
+		t.Fatalf("NewManager() error = %v", err)
 	}
 
-	// Should use default config
 	config := manager.GetConfig()
 	if config == nil {
 		t.Fatal("Expected default config, got nil")
@@ -305,4 +309,205 @@ func TestNewManager_NoConfigFile(t *testing.T) {
 	if len(config.DefaultRules.EnabledChecks) == 0 {
 		t.Error("Expected default config to have enabled checks")
 	}
+	if got := manager.Source("default_rules.checks.NO_TITLE"); got != DefaultsSource {
+		t.Errorf("Source() = %q, want %q", got, DefaultsSource)
+	}
+}
+
+func TestNewManager_MissingConfigFile(t *testing.T) {
+	existing := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(existing, []byte("default_rules:\n  enabled_checks: [NO_TITLE]\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+
+	// A missing file is an error, also when other files exist
+	_, err := NewManager(existing, "/non/existent/config.yaml")
+	if err == nil || !strings.Contains(err.Error(), "configuration file /non/existent/config.yaml not found") {
+		t.Errorf("NewManager() error = %v, want an error naming the missing file", err)
+	}
+}
+
+func TestNewManager_Thresholds(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	configContent := `default_rules:
+  enabled_checks:
+    - INVALID_WEIGHT
+thresholds:
+  expiration_in_days:
+    min: 30
+    max: 730
+  weight:
+    max: 5000
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to create test config file: %v", err)
+	}
+
+	manager, err := NewManager(configPath)
+	if err != nil {
+		t.Fatalf("NewManager() error = %v", err)
+	}
+
+	thresholds := manager.GetConfig().Thresholds
+	if thresholds.ExpirationInDays.Min == nil || *thresholds.ExpirationInDays.Min != 30 {
+		t.Errorf("Expected expiration_in_days min 30, got %v", thresholds.ExpirationInDays.Min)
+	}
+	if thresholds.ExpirationInDays.Max == nil || *thresholds.ExpirationInDays.Max != 730 {
+		t.Errorf("Expected expiration_in_days max 730, got %v", thresholds.ExpirationInDays.Max)
+	}
+	if thresholds.Weight.Min != nil {
+		t.Errorf("Expected unset weight min, got %d", *thresholds.Weight.Min)
+	}
+	if thresholds.Weight.Max == nil || *thresholds.Weight.Max != 5000 {
+		t.Errorf("Expected weight max 5000, got %v", thresholds.Weight.Max)
+	}
+}
+
+func TestRange_Bounds(t *testing.T) {
+	intPtr := func(n int) *int { return &n }
+	defaults := validator.Bounds{Min: 1, Max: 1095}
+
+	tests := []struct {
+		name  string
+		input Range
+		want  validator.Bounds
+	}{
+		{"unset keeps defaults", Range{}, defaults},
+		{"min only", Range{Min: intPtr(30)}, validator.Bounds{Min: 30, Max: 1095}},
+		{"max only", Range{Max: intPtr(365)}, validator.Bounds{Min: 1, Max: 365}},
+		{"both", Range{Min: intPtr(7), Max: intPtr(90)}, validator.Bounds{Min: 7, Max: 90}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Bounds(defaults); got != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestManager_Resolve(t *testing.T) {
+	manager := &Manager{config: &Config{
+		DefaultRules: RuleSet{
+			EnabledChecks:  []string{"NO_TITLE", "NO_OWNER", "NO_DESCRIPTION"},
+			DisabledChecks: []string{"NO_DESCRIPTION"},
+		},
+		DirectoryOverrides: []DirectoryOverride{
+			{Path: "src/content/vintage/**", DisabledChecks: []string{"NO_OWNER"}},
+			{Path: "src/content/changes/**", DisabledChecks: []string{"NO_TITLE"}},
+			{Path: "src/content/vintage/api/**", EnabledChecks: []string{"NO_OWNER", "NO_WEIGHT"}},
+		},
+		IgnorePaths: []string{"src/content/vintage/api/**"},
+	}}
+
+	want := Resolution{
+		Path:      "src/content/vintage/api/page.md",
+		IgnoredBy: "src/content/vintage/api/**",
+		MatchedOverrides: []OverrideMatch{
+			{Index: 0, Path: "src/content/vintage/**"},
+			{Index: 2, Path: "src/content/vintage/api/**"},
+		},
+		Checks: []CheckDecision{
+			{Check: "NO_TITLE", Enabled: true, Source: "default_rules.enabled_checks"},
+			{Check: "NO_OWNER", Enabled: true, Source: "directory_overrides[2].enabled_checks (src/content/vintage/api/**)"},
+			{Check: "NO_DESCRIPTION", Enabled: false, Source: "default_rules.disabled_checks"},
+			{Check: "NO_WEIGHT", Enabled: true, Source: "directory_overrides[2].enabled_checks (src/content/vintage/api/**)"},
+		},
+	}
+
+	got := manager.Resolve("src/content/vintage/api/page.md")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Resolve() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestDefaultProfile(t *testing.T) {
+	manager, err := NewManager()
+	if err != nil {
+		t.Fatalf("NewManager() error = %v", err)
+	}
+
+	// A page failing many checks, including runbook checks
+	content := `---
+title: Short
+runbook:
+  variables: invalid
+last_review_date: 2020-01-01
+weight: -5000
+diataxis_content_type: unknown
+---
+
+See [missing](/missing/).`
+
+	clock := validator.FixedClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	library := validator.New(validator.WithClock(clock))
+	configured := validator.NewWithConfig(manager, validator.WithClock(clock))
+
+	for _, filePath := range []string{
+		"src/content/page.md",
+		"src/content/changes/release.md",
+		"src/content/vintage/page.md",
+		"src/content/reference/platform-api/crd/page.md",
+	} {
+		t.Run(filePath, func(t *testing.T) {
+			if got, want := manager.GetEnabledChecksForPath(filePath), validator.DefaultEnabledChecks(); !reflect.DeepEqual(got, want) {
+				t.Errorf("GetEnabledChecksForPath() = %v, want %v", got, want)
+			}
+
+			got := configured.ValidateFile(content, filePath)
+			want := library.ValidateFile(content, filePath)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("default configuration results = %+v, want the same as validator.New() %+v", got, want)
+			}
+			if len(want.Checks) == 0 {
+				t.Error("expected findings for the test page")
+			}
+		})
+	}
+}
+
+func TestNewManagerFromBytes(t *testing.T) {
+	manager, err := NewManagerFromBytes([]byte("extends: builtin:last-reviewed\ntimezone: Europe/Berlin\n"))
+	if err != nil {
+		t.Fatalf("NewManagerFromBytes() error = %v", err)
+	}
+
+	if got := manager.GetConfig().Timezone; got != "Europe/Berlin" {
+		t.Errorf("Timezone = %q, want %q", got, "Europe/Berlin")
+	}
+	if got := manager.Source("timezone"); got != InlineSource {
+		t.Errorf("Source(timezone) = %q, want %q", got, InlineSource)
+	}
+	if got := manager.Source("default_rules.checks.NO_LAST_REVIEW_DATE"); got != "builtin:last-reviewed" {
+		t.Errorf("Source(NO_LAST_REVIEW_DATE) = %q, want the preset", got)
+	}
+
+	if _, err := NewManagerFromBytes([]byte("default_rules: [")); err == nil {
+		t.Error("NewManagerFromBytes() expected an error for invalid YAML")
+	}
+}
+
+func TestNewManagerFromConfig(t *testing.T) {
+	manager, err := NewManagerFromConfig(&Config{
+		Extends:      StringList{"builtin:last-reviewed"},
+		DefaultRules: RuleSet{DisabledChecks: []string{"REVIEW_TOO_LONG_AGO"}},
+	})
+	if err != nil {
+		t.Fatalf("NewManagerFromConfig() error = %v", err)
+	}
+
+	got := manager.GetEnabledChecksForPath("src/content/page.md")
+	if containsCheck(got, "REVIEW_TOO_LONG_AGO") || !containsCheck(got, "NO_LAST_REVIEW_DATE") {
+		t.Errorf("GetEnabledChecksForPath() = %v, want the preset without REVIEW_TOO_LONG_AGO", got)
+	}
+}
+
+func containsCheck(checks []string, check string) bool {
+	for _, c := range checks {
+		if c == check {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,239 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// SchemaURL is the location of the JSON Schema for configuration files
+const SchemaURL = "https://raw.githubusercontent.com/giantswarm/frontmatter-validator/main/frontmatter-validator.schema.json"
+
+// DefaultScaffoldThreshold is the share of failing files from which a scaffold
+// disables a check, for the whole tree or for a directory
+const DefaultScaffoldThreshold = 0.5
+
+// scaffoldMinFiles is the number of failing files a directory needs before a
+// scaffold creates an override for it, to avoid overrides for single files
+const scaffoldMinFiles = 2
+
+// ScaffoldCheck is a check in a proposed configuration
+type ScaffoldCheck struct {
+	ID      string
+	Failing int // Number of files the check fails in and that are not covered by an override
+	Files   int // Number of files considered
+}
+
+// ScaffoldOverride disables checks for a directory where they fail in most files
+type ScaffoldOverride struct {
+	Path   string
+	Checks []ScaffoldCheck
+}
+
+// Scaffold is a configuration proposed from the findings on a content tree
+type Scaffold struct {
+	Files     int
+	Enabled   []ScaffoldCheck
+	Disabled  []ScaffoldCheck
+	Overrides []ScaffoldOverride
+}
+
+// directoryStats counts the files below a directory
+type directoryStats struct {
+	files    int
+	failing  int
+	children []string
+}
+
+// NewScaffold proposes a configuration. files are all files of the content
+// tree, checks the check IDs to consider in order, and failures the files each
+// check fails in.
+//
+// Checks that fail in no file are enabled. Checks that fail in at least the
+// threshold share of all files are disabled. Otherwise, the check is disabled
+// for each topmost directory where it fails in at least the threshold share of
+// files, and stays enabled for the rest of the tree.
+func NewScaffold(files []string, checks []string, failures map[string][]string, threshold float64) *Scaffold {
+	scaffold := &Scaffold{Files: len(files)}
+
+	paths := make([]string, len(files))
+	for i, file := range files {
+		paths[i] = strings.TrimPrefix(filepath.ToSlash(file), "./")
+	}
+	root := commonDir(paths)
+
+	overrides := make(map[string][]ScaffoldCheck)
+	for _, check := range checks {
+		failing := make(map[string]bool)
+		for _, file := range failures[check] {
+			failing[strings.TrimPrefix(filepath.ToSlash(file), "./")] = true
+		}
+
+		if len(failing) == 0 {
+			scaffold.Enabled = append(scaffold.Enabled, ScaffoldCheck{ID: check, Files: len(paths)})
+			continue
+		}
+		if float64(len(failing)) >= threshold*float64(len(paths)) {
+			scaffold.Disabled = append(scaffold.Disabled, ScaffoldCheck{ID: check, Failing: len(failing), Files: len(paths)})
+			continue
+		}
+
+		stats := newDirectoryStats(paths, failing, root)
+		remaining := len(failing)
+		var visit func(dir string)
+		visit = func(dir string) {
+			s := stats[dir]
+			if dir != root && s.failing >= scaffoldMinFiles && float64(s.failing) >= threshold*float64(s.files) {
+				overrides[dir] = append(overrides[dir], ScaffoldCheck{ID: check, Failing: s.failing, Files: s.files})
+				remaining -= s.failing
+				return
+			}
+			for _, child := range s.children {
+				visit(child)
+			}
+		}
+		visit(root)
+
+		scaffold.Enabled = append(scaffold.Enabled, ScaffoldCheck{ID: check, Failing: remaining, Files: len(paths)})
+	}
+
+	dirs := make([]string, 0, len(overrides))
+	for dir := range overrides {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		scaffold.Overrides = append(scaffold.Overrides, ScaffoldOverride{Path: dir + "/**", Checks: overrides[dir]})
+	}
+
+	return scaffold
+}
+
+// newDirectoryStats counts all and failing files for every directory below
+// root, including root itself
+func newDirectoryStats(paths []string, failing map[string]bool, root string) map[string]*directoryStats {
+	stats := map[string]*directoryStats{root: {}}
+	for _, file := range paths {
+		child := ""
+		for dir := path.Dir(file); ; dir = path.Dir(dir) {
+			s := stats[dir]
+			if s == nil {
+				s = &directoryStats{}
+				stats[dir] = s
+			}
+			if child != "" && !containsDir(s.children, child) {
+				s.children = append(s.children, child)
+			}
+			s.files++
+			if failing[file] {
+				s.failing++
+			}
+			if dir == root || dir == "." || dir == "/" {
+				break
+			}
+			child = dir
+		}
+	}
+	for _, s := range stats {
+		sort.Strings(s.children)
+	}
+	return stats
+}
+
+// containsDir reports whether a directory is in the list
+func containsDir(dirs []string, dir string) bool {
+	for _, d := range dirs {
+		if d == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// commonDir returns the deepest directory containing all paths
+func commonDir(paths []string) string {
+	if len(paths) == 0 {
+		return "."
+	}
+	dir := path.Dir(paths[0])
+	for _, p := range paths[1:] {
+		for dir != "." && dir != "/" && !strings.HasPrefix(p, dir+"/") {
+			dir = path.Dir(dir)
+		}
+	}
+	return dir
+}
+
+// Config returns the proposed configuration
+func (s *Scaffold) Config() *Config {
+	config := &Config{}
+	for _, check := range s.Enabled {
+		config.DefaultRules.EnabledChecks = append(config.DefaultRules.EnabledChecks, check.ID)
+	}
+	for _, check := range s.Disabled {
+		config.DefaultRules.DisabledChecks = append(config.DefaultRules.DisabledChecks, check.ID)
+	}
+	for _, override := range s.Overrides {
+		directoryOverride := DirectoryOverride{Path: override.Path}
+		for _, check := range override.Checks {
+			directoryOverride.DisabledChecks = append(directoryOverride.DisabledChecks, check.ID)
+		}
+		config.DirectoryOverrides = append(config.DirectoryOverrides, directoryOverride)
+	}
+	return config
+}
+
+// WriteYAML writes the proposed configuration as a commented YAML file
+// referencing the JSON Schema
+func (s *Scaffold) WriteYAML(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# yaml-language-server: $schema=%s\n", SchemaURL)
+	b.WriteString("# Frontmatter Validator configuration\n")
+	fmt.Fprintf(&b, "# Generated by \"frontmatter-validator init\" from the findings in %d files.\n", s.Files)
+	b.WriteString("# Review the proposal, then fix the remaining findings or adjust the rules.\n\n")
+
+	b.WriteString("# Default rules applied to all files unless overridden\n")
+	b.WriteString("default_rules:\n")
+	b.WriteString("  # Checks that pass everywhere, or fail in a few files that should be fixed\n")
+	b.WriteString("  enabled_checks:")
+	writeScaffoldChecks(&b, "    ", s.Enabled, "currently fails")
+	if len(s.Disabled) > 0 {
+		b.WriteString("  # Checks that fail in most files. Enable them once the content is fixed.\n")
+		b.WriteString("  disabled_checks:")
+		writeScaffoldChecks(&b, "    ", s.Disabled, "fails")
+	}
+
+	if len(s.Overrides) > 0 {
+		b.WriteString("\n# Directories where checks fail in most files\n")
+		b.WriteString("directory_overrides:\n")
+		for _, override := range s.Overrides {
+			fmt.Fprintf(&b, "  - path: %q\n", override.Path)
+			b.WriteString("    disabled_checks:")
+			writeScaffoldChecks(&b, "      ", override.Checks, "fails")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeScaffoldChecks writes a YAML list of check IDs, with the number of
+// failing files as a comment
+func writeScaffoldChecks(b *strings.Builder, indent string, checks []ScaffoldCheck, verb string) {
+	if len(checks) == 0 {
+		b.WriteString(" []\n")
+		return
+	}
+	b.WriteString("\n")
+	for _, check := range checks {
+		fmt.Fprintf(b, "%s- %s", indent, check.ID)
+		if check.Failing > 0 {
+			fmt.Fprintf(b, " # %s in %d of %d files", verb, check.Failing, check.Files)
+		}
+		b.WriteString("\n")
+	}
+}
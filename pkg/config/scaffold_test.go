@@ -0,0 +1,93 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.yaml.in/yaml/v4"
+)
+
+func TestNewScaffold(t *testing.T) {
+	files := []string{
+		"content/docs/a.md",
+		"content/docs/b.md",
+		"content/docs/c.md",
+		"content/docs/guides/d.md",
+		"content/docs/guides/i.md",
+		"content/vintage/e.md",
+		"content/vintage/old/f.md",
+		"content/vintage/old/g.md",
+		"content/vintage/old/h.md",
+	}
+	checks := []string{"NO_TITLE", "NO_OWNER", "NO_DESCRIPTION", "NO_WEIGHT"}
+	failures := map[string][]string{
+		// Fails in most files
+		"NO_DESCRIPTION": {"content/docs/a.md", "content/docs/b.md", "content/docs/c.md", "content/vintage/e.md", "content/vintage/old/f.md"},
+		// Fails in most files of a directory, and in one other file
+		"NO_OWNER": {"content/vintage/e.md", "content/vintage/old/f.md", "content/vintage/old/g.md", "content/docs/a.md"},
+		// Fails in a single file of a directory, which is too few for an override
+		"NO_WEIGHT": {"content/docs/guides/d.md"},
+	}
+
+	got := NewScaffold(files, checks, failures, DefaultScaffoldThreshold)
+	want := &Scaffold{
+		Files: 9,
+		Enabled: []ScaffoldCheck{
+			{ID: "NO_TITLE", Files: 9},
+			{ID: "NO_OWNER", Failing: 1, Files: 9},
+			{ID: "NO_WEIGHT", Failing: 1, Files: 9},
+		},
+		Disabled: []ScaffoldCheck{
+			{ID: "NO_DESCRIPTION", Failing: 5, Files: 9},
+		},
+		Overrides: []ScaffoldOverride{
+			{Path: "content/vintage/**", Checks: []ScaffoldCheck{{ID: "NO_OWNER", Failing: 3, Files: 4}}},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewScaffold() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestScaffold_WriteYAML(t *testing.T) {
+	scaffold := &Scaffold{
+		Files: 8,
+		Enabled: []ScaffoldCheck{
+			{ID: "NO_TITLE", Files: 8},
+			{ID: "NO_OWNER", Failing: 1, Files: 8},
+		},
+		Disabled: []ScaffoldCheck{
+			{ID: "NO_DESCRIPTION", Failing: 5, Files: 8},
+		},
+		Overrides: []ScaffoldOverride{
+			{Path: "content/vintage/**", Checks: []ScaffoldCheck{{ID: "NO_OWNER", Failing: 3, Files: 4}}},
+		},
+	}
+
+	var b strings.Builder
+	if err := scaffold.WriteYAML(&b); err != nil {
+		t.Fatalf("WriteYAML() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"# yaml-language-server: $schema=" + SchemaURL + "\n",
+		"    - NO_OWNER # currently fails in 1 of 8 files\n",
+		"    - NO_DESCRIPTION # fails in 5 of 8 files\n",
+		"  - path: \"content/vintage/**\"\n",
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("WriteYAML() output does not contain %q:\n%s", want, b.String())
+		}
+	}
+
+	// The written file must load as the proposed configuration
+	var loaded Config
+	if err := yaml.Load([]byte(b.String()), &loaded, yaml.WithKnownFields()); err != nil {
+		t.Fatalf("loading the written configuration failed: %v", err)
+	}
+	if !reflect.DeepEqual(&loaded, scaffold.Config()) {
+		t.Errorf("loaded configuration = %+v, want %+v", loaded, scaffold.Config())
+	}
+}
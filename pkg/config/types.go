@@ -1,10 +1,88 @@
 package config
 
+import (
+	"go.yaml.in/yaml/v4"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
 // Config represents the complete configuration for frontmatter validation
 type Config struct {
+	Extends            StringList          `yaml:"extends,omitempty"` // Configurations to inherit from, as local paths or "builtin:<name>"
 	DefaultRules       RuleSet             `yaml:"default_rules"`
 	DirectoryOverrides []DirectoryOverride `yaml:"directory_overrides"`
 	IgnorePaths        []string            `yaml:"ignore_paths,omitempty"`
+	ContentExtensions  []string            `yaml:"content_extensions,omitempty"` // Extensions of content files, like ".md" and ".mdx"
+	Timezone           string              `yaml:"timezone,omitempty"`           // IANA name like "Europe/Berlin", used for date checks
+	StrictDates        bool                `yaml:"strict_dates,omitempty"`       // Reject ambiguous MM/DD/YYYY and DD/MM/YYYY dates
+	Thresholds         Thresholds          `yaml:"thresholds,omitempty"`
+	ReviewIssues       ReviewIssues        `yaml:"review_issues,omitempty"`
+	OwnerMapping       OwnerMapping        `yaml:"owner_mapping,omitempty"`
+}
+
+// LinkBaseGit derives the link base from the local git remote and branch
+const LinkBaseGit = "git"
+
+// ReviewIssues configures the review-issues output
+type ReviewIssues struct {
+	LinkBase string `yaml:"link_base,omitempty"` // Prefix for page links, or "git" to derive it from the git remote
+	Title    string `yaml:"title,omitempty"`     // Go text/template for the issue title
+	Message  string `yaml:"message,omitempty"`   // Go text/template for the issue message
+}
+
+// OwnerMapping maps page owners to issue labels, assignees and projects
+type OwnerMapping struct {
+	Owners   []OwnerRule   `yaml:"owners,omitempty"`   // Explicit rules per owner URL
+	Fallback OwnerFallback `yaml:"fallback,omitempty"` // Applies to owners without a rule and pages without owner
+}
+
+// OwnerRule maps an owner URL to issue metadata
+type OwnerRule struct {
+	Owner     string   `yaml:"owner"`
+	Labels    []string `yaml:"labels,omitempty"`
+	Assignees []string `yaml:"assignees,omitempty"`
+	Projects  []string `yaml:"projects,omitempty"` // Project IDs
+}
+
+// OwnerFallback defines the issue metadata for owners without a rule
+type OwnerFallback struct {
+	DeriveLabels *bool    `yaml:"derive_labels,omitempty"` // Derive a label like "team/phoenix" from the owner URL, defaults to true
+	Labels       []string `yaml:"labels,omitempty"`
+	Assignees    []string `yaml:"assignees,omitempty"`
+	Projects     []string `yaml:"projects,omitempty"`
+}
+
+// Thresholds defines the allowed ranges for numeric frontmatter values
+type Thresholds struct {
+	ExpirationInDays Range `yaml:"expiration_in_days,omitempty"` // Checked by INVALID_EXPIRATION_IN_DAYS
+	Weight           Range `yaml:"weight,omitempty"`             // Checked by INVALID_WEIGHT
+}
+
+// Range is an inclusive range. Unset bounds keep the built-in default.
+type Range struct {
+	Min *int `yaml:"min,omitempty"`
+	Max *int `yaml:"max,omitempty"`
+}
+
+// Bounds returns the given default bounds with the bounds set in the range
+func (r Range) Bounds(defaults validator.Bounds) validator.Bounds {
+	if r.Min != nil {
+		defaults.Min = *r.Min
+	}
+	if r.Max != nil {
+		defaults.Max = *r.Max
+	}
+	return defaults
+}
+
+// ValidatorOptions returns the validator options for the date and threshold
+// settings. The clock and timezone are left to the caller.
+func (c *Config) ValidatorOptions() []validator.Option {
+	return []validator.Option{
+		validator.WithStrictDates(c.StrictDates),
+		validator.WithExpirationBounds(c.Thresholds.ExpirationInDays.Bounds(validator.DefaultExpirationBounds)),
+		validator.WithWeightBounds(c.Thresholds.Weight.Bounds(validator.DefaultWeightBounds)),
+	}
 }
 
 // RuleSet defines which validation checks are enabled or disabled
@@ -19,3 +97,20 @@ type DirectoryOverride struct {
 	EnabledChecks  []string `yaml:"enabled_checks,omitempty"`  // Additional checks to enable for this path
 	DisabledChecks []string `yaml:"disabled_checks,omitempty"` // Checks to disable for this path
 }
+
+// StringList is a list of strings that can also be written as a single string
+type StringList []string
+
+// UnmarshalYAML accepts a single string as well as a list of strings
+func (l *StringList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*l = StringList{value.Value}
+		return nil
+	}
+	var list []string
+	if err := value.Decode(&list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
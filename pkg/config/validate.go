@@ -0,0 +1,186 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// Problem severities
+const (
+	ProblemError   = "error"
+	ProblemWarning = "warning"
+)
+
+// Problem is an issue found in a configuration file
+type Problem struct {
+	Severity string `json:"severity"`
+	Line     int    `json:"line,omitempty"`
+	Field    string `json:"field,omitempty"` // Like "directory_overrides[2].disabled_checks[0]"
+	Message  string `json:"message"`
+}
+
+// String formats the problem as "line 3: field: message"
+func (p Problem) String() string {
+	var parts []string
+	if p.Line > 0 {
+		parts = append(parts, fmt.Sprintf("line %d", p.Line))
+	}
+	if p.Field != "" {
+		parts = append(parts, p.Field)
+	}
+	return strings.Join(append(parts, p.Message), ": ")
+}
+
+// Validate checks the content of a configuration file. It reports syntax
+// errors and unknown fields, unknown and duplicate check IDs, duplicate
+// patterns and owners, checks that are both enabled and disabled, and
+// thresholds whose min is greater than their max.
+//
+// knownChecks holds the valid check IDs. If contentFiles is not nil, directory
+// overrides that match none of these files, or only ignored ones, are
+// reported as unreachable.
+func Validate(data []byte, knownChecks map[string]bool, contentFiles []string) []Problem {
+	var problems []Problem
+
+	var config Config
+	if err := yaml.Load(data, &config, yaml.WithKnownFields()); err != nil {
+		var loadErrors *yaml.LoadErrors
+		if !errors.As(err, &loadErrors) {
+			return []Problem{{Severity: ProblemError, Message: err.Error()}}
+		}
+		for _, loadErr := range loadErrors.Errors {
+			problems = append(problems, Problem{
+				Severity: ProblemError,
+				Line:     loadErr.Mark.Line,
+				Message:  loadErr.Message,
+			})
+		}
+	}
+
+	return append(problems, ValidateConfig(&config, knownChecks, contentFiles)...)
+}
+
+// ValidateConfig checks a parsed configuration like Validate, for
+// configurations built in code. Problems have no line numbers.
+func ValidateConfig(config *Config, knownChecks map[string]bool, contentFiles []string) []Problem {
+	var problems []Problem
+
+	problems = append(problems, validateRuleSet("default_rules", config.DefaultRules.EnabledChecks, config.DefaultRules.DisabledChecks, knownChecks)...)
+
+	manager := &Manager{config: config}
+	overridePaths := make(map[string]bool)
+	for i, override := range config.DirectoryOverrides {
+		field := fmt.Sprintf("directory_overrides[%d]", i)
+		problems = append(problems, validateRuleSet(field, override.EnabledChecks, override.DisabledChecks, knownChecks)...)
+
+		if override.Path == "" {
+			problems = append(problems, Problem{Severity: ProblemError, Field: field + ".path", Message: "path must not be empty"})
+			continue
+		}
+		if overridePaths[override.Path] {
+			problems = append(problems, Problem{Severity: ProblemWarning, Field: field + ".path", Message: fmt.Sprintf("duplicate path %q, the override could be merged with the earlier one", override.Path)})
+		}
+		overridePaths[override.Path] = true
+
+		if contentFiles != nil {
+			if message := manager.reachability(override.Path, contentFiles); message != "" {
+				problems = append(problems, Problem{Severity: ProblemWarning, Field: field + ".path", Message: message})
+			}
+		}
+	}
+
+	problems = append(problems, duplicates("ignore_paths", config.IgnorePaths, "pattern")...)
+	problems = append(problems, duplicates("content_extensions", config.ContentExtensions, "extension")...)
+
+	for _, threshold := range []struct {
+		field    string
+		bounds   Range
+		defaults validator.Bounds
+	}{
+		{"thresholds.expiration_in_days", config.Thresholds.ExpirationInDays, validator.DefaultExpirationBounds},
+		{"thresholds.weight", config.Thresholds.Weight, validator.DefaultWeightBounds},
+	} {
+		if bounds := threshold.bounds.Bounds(threshold.defaults); bounds.Min > bounds.Max {
+			problems = append(problems, Problem{Severity: ProblemError, Field: threshold.field, Message: fmt.Sprintf("min %d is greater than max %d, so every value fails", bounds.Min, bounds.Max)})
+		}
+	}
+
+	var owners []string
+	for _, rule := range config.OwnerMapping.Owners {
+		owners = append(owners, rule.Owner)
+	}
+	problems = append(problems, duplicates("owner_mapping.owners", owners, "owner")...)
+
+	return problems
+}
+
+// validateRuleSet checks the enabled and disabled check lists of a rule set
+func validateRuleSet(field string, enabledChecks, disabledChecks []string, knownChecks map[string]bool) []Problem {
+	var problems []Problem
+
+	for _, list := range []struct {
+		field  string
+		checks []string
+	}{
+		{field + ".enabled_checks", enabledChecks},
+		{field + ".disabled_checks", disabledChecks},
+	} {
+		for i, check := range list.checks {
+			if !knownChecks[check] {
+				problems = append(problems, Problem{Severity: ProblemError, Field: fmt.Sprintf("%s[%d]", list.field, i), Message: fmt.Sprintf("unknown check ID %q", check)})
+			}
+		}
+		problems = append(problems, duplicates(list.field, list.checks, "check ID")...)
+	}
+
+	disabled := make(map[string]bool)
+	for _, check := range disabledChecks {
+		disabled[check] = true
+	}
+	for i, check := range enabledChecks {
+		if disabled[check] {
+			// Report each check only once
+			delete(disabled, check)
+			problems = append(problems, Problem{Severity: ProblemWarning, Field: fmt.Sprintf("%s.enabled_checks[%d]", field, i), Message: fmt.Sprintf("check %q is also disabled in the same rule set, so it has no effect", check)})
+		}
+	}
+
+	return problems
+}
+
+// duplicates reports values that appear more than once in a list
+func duplicates(field string, values []string, kind string) []Problem {
+	var problems []Problem
+	seen := make(map[string]bool)
+	for i, value := range values {
+		if seen[value] {
+			problems = append(problems, Problem{Severity: ProblemWarning, Field: fmt.Sprintf("%s[%d]", field, i), Message: fmt.Sprintf("duplicate %s %q", kind, value)})
+		}
+		seen[value] = true
+	}
+	return problems
+}
+
+// reachability describes why an override pattern cannot apply to any of the
+// given files, or returns an empty string if it applies to at least one
+func (m *Manager) reachability(pattern string, files []string) string {
+	matched := 0
+	for _, file := range files {
+		if !m.pathMatches(file, pattern) {
+			continue
+		}
+		if !m.IsPathIgnored(file) {
+			return ""
+		}
+		matched++
+	}
+	if matched > 0 {
+		return fmt.Sprintf("pattern %q only matches ignored files", pattern)
+	}
+	return fmt.Sprintf("pattern %q matches no file in the content tree", pattern)
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	knownChecks := map[string]bool{"NO_TITLE": true, "NO_OWNER": true, "NO_DESCRIPTION": true}
+	contentFiles := []string{
+		"src/content/docs/a.md",
+		"src/content/archive/b.md",
+	}
+
+	tests := []struct {
+		name string
+		data string
+		want []Problem
+	}{
+		{
+			name: "valid configuration",
+			data: `default_rules:
+  enabled_checks: [NO_TITLE, NO_OWNER]
+directory_overrides:
+  - path: src/content/docs/**
+    disabled_checks: [NO_OWNER]
+`,
+			want: nil,
+		},
+		{
+			name: "unknown fields",
+			data: `default_rules:
+  enabled_checks: [NO_TITLE]
+  enable_checks: [NO_OWNER]
+timezon: Europe/Berlin
+`,
+			want: []Problem{
+				{Severity: ProblemError, Line: 3, Message: "field enable_checks not found in type config.RuleSet"},
+				{Severity: ProblemError, Line: 4, Message: "field timezon not found in type config.Config"},
+			},
+		},
+		{
+			name: "unknown and duplicate check IDs",
+			data: `default_rules:
+  enabled_checks: [NO_TITLE, NO_TITEL, NO_TITLE]
+  disabled_checks: [NO_TITLE]
+`,
+			want: []Problem{
+				{Severity: ProblemError, Field: "default_rules.enabled_checks[1]", Message: `unknown check ID "NO_TITEL"`},
+				{Severity: ProblemWarning, Field: "default_rules.enabled_checks[2]", Message: `duplicate check ID "NO_TITLE"`},
+				{Severity: ProblemWarning, Field: "default_rules.enabled_checks[0]", Message: `check "NO_TITLE" is also disabled in the same rule set, so it has no effect`},
+			},
+		},
+		{
+			name: "unreachable and duplicate overrides",
+			data: `default_rules:
+  enabled_checks: [NO_TITLE]
+directory_overrides:
+  - path: src/content/docs/**
+    disabled_checks: [NO_TITLE]
+  - path: src/content/vintage/**
+    disabled_checks: [NO_TITLE]
+  - path: src/content/archive/*
+    disabled_checks: [NO_DESCRIPTION]
+  - path: src/content/docs/**
+    disabled_checks: [NO_OWNER]
+ignore_paths:
+  - src/content/archive/**
+  - src/content/archive/**
+`,
+			want: []Problem{
+				{Severity: ProblemWarning, Field: "directory_overrides[1].path", Message: `pattern "src/content/vintage/**" matches no file in the content tree`},
+				{Severity: ProblemWarning, Field: "directory_overrides[2].path", Message: `pattern "src/content/archive/*" only matches ignored files`},
+				{Severity: ProblemWarning, Field: "directory_overrides[3].path", Message: `duplicate path "src/content/docs/**", the override could be merged with the earlier one`},
+				{Severity: ProblemWarning, Field: "ignore_paths[1]", Message: `duplicate pattern "src/content/archive/**"`},
+			},
+		},
+		{
+			name: "empty override path",
+			data: `directory_overrides:
+  - disabled_checks: [NO_TITLE]
+`,
+			want: []Problem{
+				{Severity: ProblemError, Field: "directory_overrides[0].path", Message: "path must not be empty"},
+			},
+		},
+		{
+			name: "empty threshold ranges",
+			data: `thresholds:
+  expiration_in_days:
+    min: 2000
+  weight:
+    min: 100
+    max: 10
+`,
+			want: []Problem{
+				{Severity: ProblemError, Field: "thresholds.expiration_in_days", Message: "min 2000 is greater than max 1095, so every value fails"},
+				{Severity: ProblemError, Field: "thresholds.weight", Message: "min 100 is greater than max 10, so every value fails"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate([]byte(tt.data), knownChecks, contentFiles)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() =\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_SyntaxError(t *testing.T) {
+	problems := Validate([]byte("default_rules: [\n"), nil, nil)
+	if len(problems) != 1 || problems[0].Severity != ProblemError {
+		t.Errorf("Validate() = %v, want a single error", problems)
+	}
+}
@@ -0,0 +1,387 @@
+// Package content finds the Markdown files of a Hugo content tree in any
+// file system: a directory on disk, an in-memory afero tree, or a .zip or
+// .tar.gz archive.
+package content
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/afero"
+)
+
+// DefaultExtensions are the file extensions of content files, unless
+// WithExtensions sets others
+var DefaultExtensions = []string{".md"}
+
+// Limits for the entries of .tar.gz archives, which are read into memory
+const (
+	// MaxArchiveEntrySize is the maximum size of a single extracted file
+	MaxArchiveEntrySize = 16 << 20
+	// MaxArchiveSize is the maximum total size of the extracted files
+	MaxArchiveSize = 512 << 20
+)
+
+// Option configures MarkdownFiles and OpenArchive
+type Option func(*options)
+
+type options struct {
+	extensions []string
+	include    []string
+	exclude    []string
+	gitignore  bool
+	diskRoot   string
+}
+
+// WithExtensions sets the file extensions of content files, like ".md",
+// ".markdown" and ".mdx". Without extensions, DefaultExtensions apply.
+func WithExtensions(extensions ...string) Option {
+	return func(o *options) {
+		if len(extensions) > 0 {
+			o.extensions = extensions
+		}
+	}
+}
+
+// WithInclude only returns files that match at least one of the patterns
+func WithInclude(patterns ...string) Option {
+	return func(o *options) {
+		o.include = append(o.include, patterns...)
+	}
+}
+
+// WithExclude skips files and directories that match any of the patterns
+func WithExclude(patterns ...string) Option {
+	return func(o *options) {
+		o.exclude = append(o.exclude, patterns...)
+	}
+}
+
+// WithGitignore sets whether files ignored by .gitignore files and
+// .git/info/exclude are skipped, which is the default
+func WithGitignore(enabled bool) Option {
+	return func(o *options) {
+		o.gitignore = enabled
+	}
+}
+
+// WithDiskRoot sets the directory on disk that the file system is rooted at,
+// like the directory passed to os.DirFS. It is used to find the git directory
+// of a linked worktree or submodule when it is outside the file system.
+func WithDiskRoot(dir string) Option {
+	return func(o *options) {
+		o.diskRoot = dir
+	}
+}
+
+// MarkdownFiles returns the slash-separated paths of all content files below
+// root in fsys, sorted. Use "." as root to walk the whole file system.
+//
+// Hidden directories, whose name starts with a dot, are skipped, as are files
+// and directories ignored by git: the patterns of the info/exclude file of
+// the repository at the root of fsys and of the .gitignore files in root, its parent directories and
+// its subdirectories apply. Include and exclude patterns use the gitignore
+// syntax, relative to root: "public/" matches a directory named public at any
+// depth, "docs/**/*.md" matches below root/docs.
+func MarkdownFiles(fsys fs.FS, root string, opts ...Option) ([]string, error) {
+	o := newOptions(opts)
+	return walk(fsys, root, o, func(name string) bool {
+		return HasExtension(name, o.extensions)
+	})
+}
+
+// Files returns the slash-separated paths of the files below root in fsys for
+// which match returns true, sorted. Directories are skipped, and include and
+// exclude patterns apply, like for MarkdownFiles. The extensions are not used.
+func Files(fsys fs.FS, root string, match func(name string) bool, opts ...Option) ([]string, error) {
+	return walk(fsys, root, newOptions(opts), match)
+}
+
+func newOptions(opts []Option) options {
+	o := options{extensions: DefaultExtensions, gitignore: true}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// walk returns the files below root that match, skipping hidden directories
+// and files ignored by git or the exclude patterns
+func walk(fsys fs.FS, root string, o options, match func(name string) bool) ([]string, error) {
+	include := parsePatterns(root, o.include)
+	exclude := parsePatterns(root, o.exclude)
+
+	// Patterns of deeper directories come later and take precedence
+	var ignores []pattern
+	readIgnores := func(name, dir string) error {
+		patterns, err := readPatterns(fsys, name, dir)
+		ignores = append(ignores, patterns...)
+		return err
+	}
+	if o.gitignore {
+		patterns, err := readGitExclude(fsys, o.diskRoot)
+		if err != nil {
+			return nil, err
+		}
+		ignores = append(ignores, patterns...)
+		for _, dir := range parents(root) {
+			if err := readIgnores(path.Join(dir, ".gitignore"), dir); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var paths []string
+	err := fs.WalkDir(fsys, root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if name != root && (strings.HasPrefix(d.Name(), ".") || ignored(ignores, name, true) || matchesAny(exclude, name, true)) {
+				return fs.SkipDir
+			}
+			if o.gitignore {
+				return readIgnores(path.Join(name, ".gitignore"), name)
+			}
+			return nil
+		}
+
+		if !match(name) || ignored(ignores, name, false) || matchesAny(exclude, name, false) {
+			return nil
+		}
+		if len(include) > 0 && !matchesAny(include, name, false) {
+			return nil
+		}
+		paths = append(paths, name)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// HasExtension reports whether a file name ends with one of the extensions,
+// which may be written with or without the leading dot
+func HasExtension(name string, extensions []string) bool {
+	for _, ext := range extensions {
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// parents returns the parent directories of a slash-separated path, outermost
+// first, starting with "."
+func parents(name string) []string {
+	if name == "." {
+		return nil
+	}
+	dirs := []string{"."}
+	segments := strings.Split(name, "/")
+	for i := 1; i < len(segments); i++ {
+		dirs = append(dirs, strings.Join(segments[:i], "/"))
+	}
+	return dirs
+}
+
+// FromAfero returns an afero file system as fs.FS, for use with MarkdownFiles
+// and the library's ValidateFS
+func FromAfero(afs afero.Fs) fs.FS {
+	return afero.NewIOFS(afs)
+}
+
+// IsArchive reports whether a path names an archive supported by OpenArchive,
+// judging by its extension
+func IsArchive(path string) bool {
+	return strings.HasSuffix(path, ".zip") || strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+// OpenArchive opens a .zip, .tar.gz or .tgz archive as a read-only file
+// system. Paths in the file system are the paths of the archive entries,
+// without a leading "./". The caller must close the returned closer.
+//
+// A .tar.gz archive is read into memory, so only its content files, judging
+// by the extensions set with WithExtensions, and its ignore files are
+// extracted. Files of either kind of archive are read within
+// MaxArchiveEntrySize and MaxArchiveSize.
+func OpenArchive(name string, opts ...Option) (fs.FS, io.Closer, error) {
+	o := options{extensions: DefaultExtensions}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		reader, err := zip.OpenReader(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		return newZipFS(&reader.Reader, MaxArchiveEntrySize, MaxArchiveSize), reader, nil
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		file, err := os.Open(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer file.Close()
+
+		fsys, err := readTarGz(file, o.extensions, MaxArchiveEntrySize, MaxArchiveSize)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		return fsys, nopCloser{}, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported archive %s, expected .zip, .tar.gz or .tgz", name)
+	}
+}
+
+// nopCloser is the closer of archives that are read into memory
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+// zipFS is a zip archive that limits the size of the files read from it.
+// Files larger than maxEntry bytes, or reading more than maxTotal bytes in
+// total, are an error.
+type zipFS struct {
+	fsys     fs.FS
+	maxEntry int64
+	maxTotal int64
+
+	mu    sync.Mutex
+	total int64
+}
+
+func newZipFS(fsys fs.FS, maxEntry, maxTotal int64) *zipFS {
+	return &zipFS{fsys: fsys, maxEntry: maxEntry, maxTotal: maxTotal}
+}
+
+func (z *zipFS) Open(name string) (fs.File, error) {
+	file, err := z.fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		return file, nil
+	}
+	if info.Size() > z.maxEntry {
+		file.Close()
+		return nil, archiveSizeError(name, z.maxEntry, z.maxTotal, true)
+	}
+	return &zipFile{File: file, fsys: z, name: name}, nil
+}
+
+// add counts n bytes read from the archive and returns the total
+func (z *zipFS) add(n int) int64 {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	z.total += int64(n)
+	return z.total
+}
+
+// zipFile is a file of a zipFS
+type zipFile struct {
+	fs.File
+	fsys *zipFS
+	name string
+	read int64
+}
+
+// The size in the archive can't be trusted, so the bytes read are counted
+func (f *zipFile) Read(p []byte) (int, error) {
+	n, err := f.File.Read(p)
+	f.read += int64(n)
+	if total := f.fsys.add(n); f.read > f.fsys.maxEntry || total > f.fsys.maxTotal {
+		return n, archiveSizeError(f.name, f.fsys.maxEntry, f.fsys.maxTotal, f.read > f.fsys.maxEntry)
+	}
+	return n, err
+}
+
+// readTarGz extracts the content files and ignore files of a gzip-compressed
+// tarball into an in-memory file system. Files larger than maxEntry bytes, or
+// more than maxTotal bytes of files in total, are an error.
+func readTarGz(r io.Reader, extensions []string, maxEntry, maxTotal int64) (fs.FS, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	afs := afero.NewMemMapFs()
+	var total int64
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean(strings.TrimPrefix(header.Name, "/"))
+		if !fs.ValidPath(name) {
+			return nil, fmt.Errorf("invalid path %q in archive", header.Name)
+		}
+		if !HasExtension(name, extensions) && path.Base(name) != ".gitignore" && name != ".git/info/exclude" {
+			continue
+		}
+
+		// The header size can't be trusted, so the copy is limited as well
+		limit := min(maxEntry, maxTotal-total)
+		if header.Size > limit {
+			return nil, archiveSizeError(name, maxEntry, maxTotal, header.Size > maxEntry)
+		}
+		if err := afs.MkdirAll(path.Dir(name), 0755); err != nil {
+			return nil, err
+		}
+		file, err := afs.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		n, err := io.Copy(file, io.LimitReader(tr, limit+1))
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract %s: %w", name, err)
+		}
+		if n > limit {
+			return nil, archiveSizeError(name, maxEntry, maxTotal, n > maxEntry)
+		}
+		total += n
+	}
+	return FromAfero(afs), nil
+}
+
+// archiveSizeError reports an archive entry that exceeds the size limits
+func archiveSizeError(name string, maxEntry, maxTotal int64, entryTooLarge bool) error {
+	if entryTooLarge {
+		return fmt.Errorf("%s is larger than the limit of %d bytes per file", name, maxEntry)
+	}
+	return fmt.Errorf("extracting %s exceeds the limit of %d bytes for all files", name, maxTotal)
+}
@@ -0,0 +1,498 @@
+package content
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/spf13/afero"
+
+	"github.com/giantswarm/frontmatter-validator/internal/testutil"
+)
+
+var testFiles = map[string]string{
+	"src/content/_index.md":        "---\ntitle: Docs\n---\n",
+	"src/content/install/index.md": "---\ntitle: Install\n---\n",
+	"src/content/install/cli.png":  "",
+	"README.md":                    "# Docs\n",
+}
+
+var wantFiles = []string{
+	"README.md",
+	"src/content/_index.md",
+	"src/content/install/index.md",
+}
+
+func TestMarkdownFiles(t *testing.T) {
+	mapFS := fstest.MapFS{}
+	memFS := afero.NewMemMapFs()
+	for name, data := range testFiles {
+		mapFS[name] = &fstest.MapFile{Data: []byte(data)}
+		if err := afero.WriteFile(memFS, name, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		fsys fs.FS
+		root string
+		want []string
+	}{
+		{"fs.FS", mapFS, ".", wantFiles},
+		{"afero", FromAfero(memFS), ".", wantFiles},
+		{"subdirectory", mapFS, "src/content/install", []string{"src/content/install/index.md"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarkdownFiles(tt.fsys, tt.root)
+			if err != nil {
+				t.Fatalf("MarkdownFiles() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarkdownFiles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownFiles_Options(t *testing.T) {
+	fsys := fstest.MapFS{
+		".git/info/exclude":              {Data: []byte("# local notes\nnotes.md\n")},
+		".gitignore":                     {Data: []byte("node_modules/\n/public\n*.draft.md\n")},
+		".github/pull_request.md":        {},
+		"notes.md":                       {},
+		"node_modules/pkg/README.md":     {},
+		"public/index.md":                {},
+		"src/content/_index.md":          {},
+		"src/content/page.draft.md":      {},
+		"src/content/public/index.md":    {},
+		"src/content/guide.markdown":     {},
+		"src/content/api/.gitignore":     {Data: []byte("generated/\n!keep.draft.md\n")},
+		"src/content/api/generated/a.md": {},
+		"src/content/api/keep.draft.md":  {},
+		"src/content/api/v1/index.mdx":   {},
+		"themes/docs/layouts/README.md":  {},
+	}
+
+	tests := []struct {
+		name string
+		root string
+		opts []Option
+		want []string
+	}{
+		{
+			name: "defaults",
+			root: ".",
+			want: []string{"src/content/_index.md", "src/content/api/keep.draft.md", "src/content/public/index.md", "themes/docs/layouts/README.md"},
+		},
+		{
+			name: "without gitignore",
+			root: ".",
+			opts: []Option{WithGitignore(false)},
+			want: []string{
+				"node_modules/pkg/README.md",
+				"notes.md",
+				"public/index.md",
+				"src/content/_index.md",
+				"src/content/api/generated/a.md",
+				"src/content/api/keep.draft.md",
+				"src/content/page.draft.md",
+				"src/content/public/index.md",
+				"themes/docs/layouts/README.md",
+			},
+		},
+		{
+			name: "subdirectory uses parent gitignore",
+			root: "src/content",
+			want: []string{"src/content/_index.md", "src/content/api/keep.draft.md", "src/content/public/index.md"},
+		},
+		{
+			name: "extensions",
+			root: "src/content",
+			opts: []Option{WithExtensions(".md", "markdown", ".mdx")},
+			want: []string{"src/content/_index.md", "src/content/api/keep.draft.md", "src/content/api/v1/index.mdx", "src/content/guide.markdown", "src/content/public/index.md"},
+		},
+		{
+			name: "include and exclude",
+			root: ".",
+			opts: []Option{WithInclude("src/**"), WithExclude("public/", "_index.md")},
+			want: []string{"src/content/api/keep.draft.md"},
+		},
+		{
+			name: "include relative to root",
+			root: "src/content",
+			opts: []Option{WithInclude("api/*.md")},
+			want: []string{"src/content/api/keep.draft.md"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarkdownFiles(fsys, tt.root, tt.opts...)
+			if err != nil {
+				t.Fatalf("MarkdownFiles() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarkdownFiles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownFiles_GitignoreNegation(t *testing.T) {
+	// A trailing "**" ignores everything inside docs, but not docs itself, so
+	// a negation can re-include a file in it, like in git
+	fsys := fstest.MapFS{
+		".gitignore":        {Data: []byte("docs/**\n!docs/keep.md\n")},
+		"docs/drop.md":      {},
+		"docs/keep.md":      {},
+		"docs/api/index.md": {},
+		"index.md":          {},
+	}
+
+	got, err := MarkdownFiles(fsys, ".")
+	if err != nil {
+		t.Fatalf("MarkdownFiles() error = %v", err)
+	}
+	if want := []string{"docs/keep.md", "index.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MarkdownFiles() = %v, want %v", got, want)
+	}
+}
+
+func TestOpenArchive(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		write   func(t *testing.T, path string, files map[string]string)
+		files   map[string]string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:  "content.zip",
+			write: writeZip,
+			files: testFiles,
+			want:  wantFiles,
+		},
+		{
+			name:  "content.tar.gz",
+			write: writeTarGz,
+			files: testFiles,
+			want:  wantFiles,
+		},
+		{
+			name:  "dotted.tgz",
+			write: writeTarGz,
+			files: map[string]string{"./src/content/page.md": "---\ntitle: Page\n---\n"},
+			want:  []string{"src/content/page.md"},
+		},
+		{
+			name:    "escaping.tar.gz",
+			write:   writeTarGz,
+			files:   map[string]string{"../page.md": ""},
+			wantErr: `invalid path "../page.md" in archive`,
+		},
+		{
+			name:    "content.rar",
+			write:   func(*testing.T, string, map[string]string) {},
+			wantErr: "unsupported archive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name)
+			tt.write(t, path, tt.files)
+
+			fsys, closer, err := OpenArchive(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("OpenArchive() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("OpenArchive() error = %v", err)
+			}
+			defer closer.Close()
+
+			got, err := MarkdownFiles(fsys, ".")
+			if err != nil {
+				t.Fatalf("MarkdownFiles() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarkdownFiles() = %v, want %v", got, tt.want)
+			}
+			for _, name := range got {
+				data, err := fs.ReadFile(fsys, name)
+				if err != nil {
+					t.Fatalf("ReadFile(%q) error = %v", name, err)
+				}
+				if want := tt.files[name]; want != "" && string(data) != want {
+					t.Errorf("ReadFile(%q) = %q, want %q", name, data, want)
+				}
+			}
+		})
+	}
+}
+
+func writeZip(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+	writeArchive(t, path, func(w io.Writer) {
+		zw := zip.NewWriter(w)
+		for name, data := range files {
+			fw, err := zw.Create(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := fw.Write([]byte(data)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := zw.Close(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func writeTarGz(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+	writeArchive(t, path, func(w io.Writer) {
+		gz := gzip.NewWriter(w)
+		tw := tar.NewWriter(gz)
+		for name, data := range files {
+			header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), Typeflag: tar.TypeReg}
+			if err := tw.WriteHeader(header); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := tw.Write([]byte(data)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := tw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := gz.Close(); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func writeArchive(t *testing.T, path string, write func(w io.Writer)) {
+	t.Helper()
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	write(file)
+}
+
+func TestReadTarGz(t *testing.T) {
+	files := map[string]string{
+		".gitignore":            "public/\n",
+		".git/info/exclude":     "notes.md\n",
+		"src/content/page.md":   "---\ntitle: Page\n---\n",
+		"src/content/guide.mdx": "---\ntitle: Guide\n---\n",
+		"src/content/image.png": strings.Repeat("x", 100),
+		"src/content/big.md":    strings.Repeat("x", 60),
+	}
+	archive := filepath.Join(t.TempDir(), "content.tar.gz")
+	writeTarGz(t, archive, files)
+
+	tests := []struct {
+		name       string
+		extensions []string
+		maxEntry   int64
+		maxTotal   int64
+		want       []string
+		wantErr    string
+	}{
+		{
+			name:       "content and ignore files only",
+			extensions: DefaultExtensions,
+			maxEntry:   80,
+			maxTotal:   1000,
+			want:       []string{".git/info/exclude", ".gitignore", "src/content/big.md", "src/content/page.md"},
+		},
+		{
+			name:       "extensions",
+			extensions: []string{".mdx"},
+			maxEntry:   80,
+			maxTotal:   1000,
+			want:       []string{".git/info/exclude", ".gitignore", "src/content/guide.mdx"},
+		},
+		{
+			name:       "entry too large",
+			extensions: DefaultExtensions,
+			maxEntry:   50,
+			maxTotal:   1000,
+			wantErr:    "src/content/big.md is larger than the limit of 50 bytes per file",
+		},
+		{
+			name:       "total too large",
+			extensions: DefaultExtensions,
+			maxEntry:   80,
+			maxTotal:   70,
+			wantErr:    "exceeds the limit of 70 bytes for all files",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := os.Open(archive)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			fsys, err := readTarGz(file, tt.extensions, tt.maxEntry, tt.maxTotal)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("readTarGz() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readTarGz() error = %v", err)
+			}
+
+			var got []string
+			err = fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+				if err == nil && !d.IsDir() {
+					got = append(got, name)
+				}
+				return err
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("extracted files = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZipFS(t *testing.T) {
+	files := map[string]string{
+		"src/content/page.md": "---\ntitle: Page\n---\n",
+		"src/content/big.md":  strings.Repeat("x", 60),
+	}
+	archive := filepath.Join(t.TempDir(), "content.zip")
+	writeZip(t, archive, files)
+
+	tests := []struct {
+		name     string
+		maxEntry int64
+		maxTotal int64
+		wantErr  string
+	}{
+		{
+			name:     "within limits",
+			maxEntry: 80,
+			maxTotal: 1000,
+		},
+		{
+			name:     "entry too large",
+			maxEntry: 50,
+			maxTotal: 1000,
+			wantErr:  "src/content/big.md is larger than the limit of 50 bytes per file",
+		},
+		{
+			name:     "total too large",
+			maxEntry: 80,
+			maxTotal: 70,
+			wantErr:  "exceeds the limit of 70 bytes for all files",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := zip.OpenReader(archive)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer reader.Close()
+			fsys := newZipFS(&reader.Reader, tt.maxEntry, tt.maxTotal)
+
+			paths, err := MarkdownFiles(fsys, ".")
+			if err != nil {
+				t.Fatalf("MarkdownFiles() error = %v", err)
+			}
+			for _, name := range paths {
+				data, err := fs.ReadFile(fsys, name)
+				if err != nil {
+					if tt.wantErr == "" || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Fatalf("ReadFile(%q) error = %v, want it to contain %q", name, err, tt.wantErr)
+					}
+					return
+				}
+				if string(data) != files[name] {
+					t.Errorf("ReadFile(%q) = %q, want %q", name, data, files[name])
+				}
+			}
+			if tt.wantErr != "" {
+				t.Errorf("ReadFile() error = nil, want it to contain %q", tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMarkdownFiles_GitFile(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFiles(t, dir, map[string]string{
+		// Main repository with a linked worktree and a submodule
+		"main/.git/info/exclude":                  "notes.md\n",
+		"main/.git/worktrees/wt/commondir":        "../..\n",
+		"main/.git/modules/sub/info/exclude":      "draft.md\n",
+		"main/sub/.git":                           "gitdir: ../.git/modules/sub\n",
+		"main/sub/draft.md":                       "",
+		"main/sub/page.md":                        "",
+		"wt/.git":                                 "gitdir: " + filepath.Join(dir, "main/.git/worktrees/wt") + "\n",
+		"wt/notes.md":                             "",
+		"wt/page.md":                              "",
+		"broken/.git":                             "gitdir: /nonexistent/.git/worktrees/broken\n",
+		"broken/page.md":                          "",
+		"embedded/.git":                           "gitdir: .modules/embedded\n",
+		"embedded/.modules/embedded/info/exclude": "notes.md\n",
+		"embedded/notes.md":                       "",
+		"embedded/page.md":                        "",
+	})
+
+	tests := []struct {
+		name string
+		root string
+		opts []Option
+		want []string
+	}{
+		{name: "worktree", root: "wt", want: []string{"page.md"}},
+		{name: "submodule", root: "main/sub", opts: []Option{WithDiskRoot(filepath.Join(dir, "main/sub"))}, want: []string{"page.md"}},
+		{name: "submodule without disk root", root: "main/sub", want: []string{"draft.md", "page.md"}},
+		{name: "git directory inside the file system", root: "embedded", want: []string{"page.md"}},
+		{name: "missing git directory", root: "broken", want: []string{"page.md"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarkdownFiles(os.DirFS(filepath.Join(dir, tt.root)), ".", tt.opts...)
+			if err != nil {
+				t.Fatalf("MarkdownFiles() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarkdownFiles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
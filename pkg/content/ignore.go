@@ -0,0 +1,238 @@
+package content
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// pattern is a gitignore-style pattern. The same syntax is used for the
+// include and exclude patterns.
+type pattern struct {
+	dir      string   // Directory the pattern is relative to, "." for the root
+	segments []string // Slash-separated parts, where "**" matches any number of directories
+	anchored bool     // Matches the path relative to dir, not only the name
+	dirOnly  bool     // Only matches directories, written with a trailing slash
+	negate   bool     // Re-includes a path, written with a leading "!"
+}
+
+// parsePattern parses a pattern relative to a directory. It returns false for
+// blank lines and comments.
+func parsePattern(dir, line string) (pattern, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return pattern{}, false
+	}
+
+	p := pattern{dir: dir}
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	}
+	line = strings.TrimPrefix(line, `\`)
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	line = strings.TrimPrefix(line, "./")
+	if strings.Contains(line, "/") {
+		p.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+	if line == "" {
+		return pattern{}, false
+	}
+	p.segments = strings.Split(line, "/")
+	return p, true
+}
+
+// parsePatterns parses patterns, like the lines of a .gitignore file, relative
+// to a directory
+func parsePatterns(dir string, lines []string) []pattern {
+	var patterns []pattern
+	for _, line := range lines {
+		if p, ok := parsePattern(dir, line); ok {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+// readPatterns reads an ignore file of fsys. A missing file has no patterns.
+func readPatterns(fsys fs.FS, name, dir string) ([]pattern, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return scanPatterns(data, dir)
+}
+
+// scanPatterns parses the lines of an ignore file relative to a directory
+func scanPatterns(data []byte, dir string) ([]pattern, error) {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return parsePatterns(dir, lines), scanner.Err()
+}
+
+// match reports whether the pattern matches a slash-separated path of fsys
+func (p pattern) match(name string, isDir bool) bool {
+	if p.dirOnly && !isDir {
+		return false
+	}
+
+	rel := name
+	if p.dir != "." {
+		if !strings.HasPrefix(name, p.dir+"/") {
+			return false
+		}
+		rel = strings.TrimPrefix(name, p.dir+"/")
+	}
+
+	if !p.anchored {
+		return matchSegments(p.segments, []string{path.Base(rel)})
+	}
+	return matchSegments(p.segments, strings.Split(rel, "/"))
+}
+
+// matchSegments matches path segments against pattern segments, where "**"
+// matches zero or more segments and other segments are path.Match patterns.
+// Like in git, a trailing "**" matches everything inside a directory, but not
+// the directory itself.
+func matchSegments(pattern, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+	if pattern[0] == "**" && len(pattern) == 1 {
+		return len(name) > 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(name); i++ {
+			if matchSegments(pattern[1:], name[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(name) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pattern[0], name[0]); !ok {
+		return false
+	}
+	return matchSegments(pattern[1:], name[1:])
+}
+
+// ignored applies patterns in order, so later patterns override earlier ones,
+// like the lines of a .gitignore file
+func ignored(patterns []pattern, name string, isDir bool) bool {
+	result := false
+	for _, p := range patterns {
+		if p.match(name, isDir) {
+			result = !p.negate
+		}
+	}
+	return result
+}
+
+// matchesAny reports whether any pattern matches a path
+func matchesAny(patterns []pattern, name string, isDir bool) bool {
+	for _, p := range patterns {
+		if p.match(name, isDir) {
+			return true
+		}
+	}
+	return false
+}
+
+// gitPath is a path in the walked file system, or an absolute path on disk
+// for git directories outside of it
+type gitPath struct {
+	name   string
+	onDisk bool
+}
+
+// join resolves a path found in a git file relative to the directory p. Paths
+// that leave the walked file system can only be resolved on disk, if the
+// directory of the file system on disk is known.
+func (p gitPath) join(target, diskRoot string) (gitPath, bool) {
+	switch {
+	case filepath.IsAbs(target):
+		return gitPath{name: filepath.Clean(target), onDisk: true}, true
+	case p.onDisk:
+		return gitPath{name: filepath.Join(p.name, target), onDisk: true}, true
+	}
+	name := path.Join(p.name, filepath.ToSlash(target))
+	if fs.ValidPath(name) {
+		return gitPath{name: name}, true
+	}
+	if diskRoot == "" {
+		return gitPath{}, false
+	}
+	return gitPath{name: filepath.Join(diskRoot, filepath.FromSlash(p.name), target), onDisk: true}, true
+}
+
+func (p gitPath) readFile(fsys fs.FS, name string) ([]byte, error) {
+	if p.onDisk {
+		return os.ReadFile(filepath.Join(p.name, filepath.FromSlash(name)))
+	}
+	return fs.ReadFile(fsys, path.Join(p.name, name))
+}
+
+// readGitExclude reads the info/exclude file of the git repository at the
+// root of fsys. Besides a .git directory, this is a .git file with a
+// "gitdir: <path>" line, as in linked worktrees and submodules. Linked
+// worktrees share the info directory of the main repository, which their git
+// directory names in a commondir file. Without a repository, or if its git
+// directory can't be found, there are no patterns.
+func readGitExclude(fsys fs.FS, diskRoot string) ([]pattern, error) {
+	info, err := fs.Stat(fsys, ".git")
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	gitDir := gitPath{name: ".git"}
+	if !info.IsDir() {
+		data, err := fs.ReadFile(fsys, ".git")
+		if err != nil {
+			return nil, err
+		}
+		target, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+		if !ok {
+			return nil, nil
+		}
+		if gitDir, ok = (gitPath{name: "."}).join(strings.TrimSpace(target), diskRoot); !ok {
+			return nil, nil
+		}
+		if data, err := gitDir.readFile(fsys, "commondir"); err == nil {
+			if gitDir, ok = gitDir.join(strings.TrimSpace(string(data)), diskRoot); !ok {
+				return nil, nil
+			}
+		}
+	}
+
+	data, err := gitDir.readFile(fsys, "info/exclude")
+	if err != nil {
+		// A git directory that is gone, or a file instead of a directory, has
+		// no exclude file either
+		if errors.Is(err, fs.ErrNotExist) || errors.Is(err, syscall.ENOTDIR) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return scanPatterns(data, ".")
+}
@@ -0,0 +1,113 @@
+package fix
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// DateOrder tells how to read dates written as NN/NN/YYYY
+type DateOrder string
+
+// Supported date orders
+const (
+	DateOrderUnknown DateOrder = ""
+	DateOrderMDY     DateOrder = "mdy"
+	DateOrderDMY     DateOrder = "dmy"
+)
+
+// slashDateRegex matches a date frontmatter line like `last_review_date: "03/04/2025"`
+var slashDateRegex = regexp.MustCompile(`^(last_review_date|date)(\s*:\s*)(["']?)(\d{1,2})/(\d{1,2})/(\d{4})(["']?)(\s*(?:#.*)?)$`)
+
+// DateChange describes a date value that was rewritten, or that could not be
+type DateChange struct {
+	Key     string
+	Line    int
+	Old     string
+	New     string
+	Skipped bool
+	Reason  string
+}
+
+// ParseDateOrder validates a date order given on the command line
+func ParseDateOrder(s string) (DateOrder, error) {
+	switch order := DateOrder(strings.ToLower(s)); order {
+	case DateOrderUnknown, DateOrderMDY, DateOrderDMY:
+		return order, nil
+	}
+	return DateOrderUnknown, fmt.Errorf("unknown date order %q, expected 'mdy' or 'dmy'", s)
+}
+
+// Dates rewrites last_review_date and date values written as MM/DD/YYYY or
+// DD/MM/YYYY in the frontmatter to YYYY-MM-DD. Values where the order of day
+// and month is evident, like 25/03/2025, are always rewritten. Others are only
+// rewritten if an order is given, and reported as skipped otherwise.
+func Dates(content string, order DateOrder) (string, []DateChange) {
+	if !strings.HasPrefix(content, "---\n") {
+		return content, nil
+	}
+
+	lines := strings.Split(content, "\n")
+	var changes []DateChange
+
+	for i := 1; i < len(lines); i++ {
+		if lines[i] == "---" {
+			break
+		}
+
+		match := slashDateRegex.FindStringSubmatch(lines[i])
+		if match == nil {
+			continue
+		}
+
+		change := DateChange{
+			Key:  match[1],
+			Line: i + 1,
+			Old:  match[4] + "/" + match[5] + "/" + match[6],
+		}
+
+		iso, err := toISO(match[4], match[5], match[6], order)
+		if err != nil {
+			change.Skipped = true
+			change.Reason = err.Error()
+		} else {
+			change.New = iso
+			lines[i] = match[1] + match[2] + match[3] + iso + match[7] + match[8]
+		}
+		changes = append(changes, change)
+	}
+
+	return strings.Join(lines, "\n"), changes
+}
+
+// toISO converts the parts of a slash date to YYYY-MM-DD
+func toISO(first, second, year string, order DateOrder) (string, error) {
+	a, _ := strconv.Atoi(first)
+	b, _ := strconv.Atoi(second)
+
+	var layout string
+	switch {
+	case a > 12 && b > 12:
+		return "", fmt.Errorf("neither part can be a month")
+	case a > 12:
+		layout = validator.DateLayoutEUSlash
+	case b > 12 || a == b:
+		layout = validator.DateLayoutUSSlash
+	case order == DateOrderMDY:
+		layout = validator.DateLayoutUSSlash
+	case order == DateOrderDMY:
+		layout = validator.DateLayoutEUSlash
+	default:
+		return "", fmt.Errorf("ambiguous order of day and month, pass a date order")
+	}
+
+	t, err := time.Parse(layout, fmt.Sprintf("%02d/%02d/%s", a, b, year))
+	if err != nil {
+		return "", fmt.Errorf("not a valid date")
+	}
+	return t.Format(validator.DateLayoutISO), nil
+}
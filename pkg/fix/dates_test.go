@@ -0,0 +1,102 @@
+package fix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDates(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		order       DateOrder
+		wantContent string
+		wantChanges []DateChange
+	}{
+		{
+			name:        "ISO dates are left alone",
+			content:     "---\ntitle: Test\nlast_review_date: 2025-03-04\n---\n",
+			wantContent: "---\ntitle: Test\nlast_review_date: 2025-03-04\n---\n",
+		},
+		{
+			name:        "unambiguous day first",
+			content:     "---\nlast_review_date: 25/03/2025\n---\n",
+			wantContent: "---\nlast_review_date: 2025-03-25\n---\n",
+			wantChanges: []DateChange{{Key: "last_review_date", Line: 2, Old: "25/03/2025", New: "2025-03-25"}},
+		},
+		{
+			name:        "unambiguous month first keeps quotes and comments",
+			content:     "---\ndate: \"3/25/2025\" # release\n---\n",
+			wantContent: "---\ndate: \"2025-03-25\" # release\n---\n",
+			wantChanges: []DateChange{{Key: "date", Line: 2, Old: "3/25/2025", New: "2025-03-25"}},
+		},
+		{
+			name:        "ambiguous without order is skipped",
+			content:     "---\nlast_review_date: 03/04/2025\n---\n",
+			wantContent: "---\nlast_review_date: 03/04/2025\n---\n",
+			wantChanges: []DateChange{{Key: "last_review_date", Line: 2, Old: "03/04/2025", Skipped: true, Reason: "ambiguous order of day and month, pass a date order"}},
+		},
+		{
+			name:        "ambiguous with month first order",
+			content:     "---\nlast_review_date: 03/04/2025\n---\n",
+			order:       DateOrderMDY,
+			wantContent: "---\nlast_review_date: 2025-03-04\n---\n",
+			wantChanges: []DateChange{{Key: "last_review_date", Line: 2, Old: "03/04/2025", New: "2025-03-04"}},
+		},
+		{
+			name:        "ambiguous with day first order",
+			content:     "---\nlast_review_date: 03/04/2025\n---\n",
+			order:       DateOrderDMY,
+			wantContent: "---\nlast_review_date: 2025-04-03\n---\n",
+			wantChanges: []DateChange{{Key: "last_review_date", Line: 2, Old: "03/04/2025", New: "2025-04-03"}},
+		},
+		{
+			name:        "invalid date is skipped",
+			content:     "---\nlast_review_date: 31/02/2025\n---\n",
+			wantContent: "---\nlast_review_date: 31/02/2025\n---\n",
+			wantChanges: []DateChange{{Key: "last_review_date", Line: 2, Old: "31/02/2025", Skipped: true, Reason: "not a valid date"}},
+		},
+		{
+			name:        "body is not touched",
+			content:     "---\ntitle: Test\n---\ndate: 25/03/2025\n",
+			wantContent: "---\ntitle: Test\n---\ndate: 25/03/2025\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, changes := Dates(tt.content, tt.order)
+			if content != tt.wantContent {
+				t.Errorf("Expected content %q, got %q", tt.wantContent, content)
+			}
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Errorf("Expected changes %+v, got %+v", tt.wantChanges, changes)
+			}
+		})
+	}
+}
+
+func TestParseDateOrder(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    DateOrder
+		wantErr bool
+	}{
+		{"", DateOrderUnknown, false},
+		{"mdy", DateOrderMDY, false},
+		{"DMY", DateOrderDMY, false},
+		{"ymd", DateOrderUnknown, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDateOrder(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unexpected error state: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
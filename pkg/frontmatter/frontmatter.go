@@ -0,0 +1,311 @@
+// Package frontmatter is the library API of the frontmatter validator, for
+// programs that validate Hugo content in-process.
+//
+// A Linter is created with functional options and is safe for concurrent use:
+//
+//	linter, err := frontmatter.New(
+//		frontmatter.WithConfigFiles("frontmatter-validator.yaml"),
+//		frontmatter.WithClock(validator.FixedClock(buildTime)),
+//	)
+//	if err != nil {
+//		return err
+//	}
+//	report, err := linter.ValidateFS(ctx, os.DirFS("."))
+//	if err != nil {
+//		return err
+//	}
+//	if report.HasFailures() {
+//		...
+//	}
+//
+// Without configuration option, the default profile of
+// validator.DefaultEnabledChecks applies to all files.
+package frontmatter
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/config"
+	"github.com/giantswarm/frontmatter-validator/pkg/content"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// Linter validates the frontmatter of Markdown files
+type Linter struct {
+	validator     *validator.Validator
+	configManager validator.ConfigManager
+	checks        map[string]validator.Check
+	extensions    []string
+}
+
+// Option configures a Linter
+type Option func(*options)
+
+// options collects the settings of all options, which New checks and applies
+type options struct {
+	configs     int
+	configData  []byte
+	config      *config.Config
+	configPaths []string
+	clock       validator.Clock
+	location    *time.Location
+	checks      []string
+}
+
+// WithConfig uses a configuration struct, as read from a configuration file
+func WithConfig(cfg *config.Config) Option {
+	return func(o *options) {
+		o.configs++
+		o.config = cfg
+	}
+}
+
+// WithConfigBytes uses the YAML content of a configuration file
+func WithConfigBytes(data []byte) Option {
+	return func(o *options) {
+		o.configs++
+		o.configData = data
+	}
+}
+
+// WithConfigFiles uses configuration files, layered in the given order with
+// later files taking precedence, like the --config flag of the command line
+// tool
+func WithConfigFiles(paths ...string) Option {
+	return func(o *options) {
+		o.configs++
+		o.configPaths = paths
+	}
+}
+
+// WithClock sets the clock used to determine today's date for date checks,
+// instead of the system clock
+func WithClock(clock validator.Clock) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
+// WithLocation sets the timezone in which today's date is determined, instead
+// of the timezone setting of the configuration or the local timezone
+func WithLocation(location *time.Location) Option {
+	return func(o *options) {
+		o.location = location
+	}
+}
+
+// WithChecks enables exactly the given checks for all files, instead of the
+// checks enabled by the configuration. Other settings of the configuration,
+// like ignore_paths and thresholds, still apply.
+func WithChecks(ids ...string) Option {
+	return func(o *options) {
+		o.checks = ids
+	}
+}
+
+// checkSet enables a fixed set of checks, keeping the ignored paths of the
+// configuration
+type checkSet struct {
+	validator.ConfigManager
+	checks []string
+}
+
+func (c checkSet) GetEnabledChecksForPath(filePath string) []string {
+	return c.checks
+}
+
+// New creates a Linter. It returns an error if the configuration can't be
+// loaded or is invalid, if more than one configuration option is given, or if
+// WithChecks names unknown checks. The configuration is checked like the
+// config validate command does: unknown fields and check IDs, empty threshold
+// ranges and other error-severity problems are returned as an error.
+func New(opts ...Option) (*Linter, error) {
+	o := options{clock: validator.SystemClock}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	configManager, err := o.configManager()
+	if err != nil {
+		return nil, err
+	}
+	cfg := configManager.GetConfig()
+
+	location := o.location
+	if location == nil {
+		location = time.Local
+		if cfg.Timezone != "" {
+			location, err = time.LoadLocation(cfg.Timezone)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timezone %q in configuration: %w", cfg.Timezone, err)
+			}
+		}
+	}
+
+	linter := &Linter{
+		configManager: configManager,
+		checks:        make(map[string]validator.Check),
+		extensions:    cfg.ContentExtensions,
+	}
+	for _, check := range validator.GetChecks() {
+		linter.checks[check.ID] = check
+	}
+
+	if o.checks != nil {
+		for _, id := range o.checks {
+			if _, ok := linter.checks[id]; !ok {
+				return nil, fmt.Errorf("unknown check %q", id)
+			}
+		}
+		linter.configManager = checkSet{ConfigManager: configManager, checks: o.checks}
+	}
+
+	validatorOpts := append([]validator.Option{
+		validator.WithClock(o.clock),
+		validator.WithLocation(location),
+	}, cfg.ValidatorOptions()...)
+	linter.validator = validator.NewWithConfig(linter.configManager, validatorOpts...)
+	return linter, nil
+}
+
+// configManager validates and loads the configuration given by the options
+func (o *options) configManager() (*config.Manager, error) {
+	knownChecks := make(map[string]bool)
+	for _, check := range validator.GetChecks() {
+		knownChecks[check.ID] = true
+	}
+
+	switch {
+	case o.configs > 1:
+		return nil, fmt.Errorf("only one of WithConfig, WithConfigBytes and WithConfigFiles can be used")
+	case o.config != nil:
+		if err := problemsError(config.InlineSource, config.ValidateConfig(o.config, knownChecks, nil)); err != nil {
+			return nil, err
+		}
+		return config.NewManagerFromConfig(o.config)
+	case o.configData != nil:
+		if err := problemsError(config.InlineSource, config.Validate(o.configData, knownChecks, nil)); err != nil {
+			return nil, err
+		}
+		return config.NewManagerFromBytes(o.configData)
+	default:
+		manager, err := config.NewManager(o.configPaths...)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range o.configPaths {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+			if err := problemsError(path, config.Validate(data, knownChecks, nil)); err != nil {
+				return nil, err
+			}
+		}
+		return manager, nil
+	}
+}
+
+// problemsError returns the error-severity problems of a configuration as an
+// error, or nil if there are none
+func problemsError(name string, problems []config.Problem) error {
+	var messages []string
+	for _, problem := range problems {
+		if problem.Severity == config.ProblemError {
+			messages = append(messages, problem.String())
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid configuration %s: %s", name, strings.Join(messages, "; "))
+}
+
+// ValidateFile validates the content of a single Markdown file. The path is
+// used to apply the configuration and is reported in the result. Link checks
+// need the other files of the content tree and are only run by ValidateFS.
+func (l *Linter) ValidateFile(ctx context.Context, path string, content []byte) (FileResult, error) {
+	if err := ctx.Err(); err != nil {
+		return FileResult{}, err
+	}
+	return l.fileResult(path, l.validator.ValidateFile(string(content), path)), nil
+}
+
+// ValidateFS validates all content files in a file system, including the link
+// checks across files. Files are found like content.MarkdownFiles does, with
+// the content_extensions of the configuration. Use content.FromAfero for an
+// afero file system and content.OpenArchive for a .zip or .tar.gz archive.
+// Paths in the report and in configuration patterns are slash-separated paths
+// within fsys, like "src/content/page.md". It stops with the context's error
+// when the context is cancelled.
+func (l *Linter) ValidateFS(ctx context.Context, fsys fs.FS) (*Report, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	paths, err := content.MarkdownFiles(fsys, ".", content.WithExtensions(l.extensions...))
+	if err != nil {
+		return nil, err
+	}
+
+	contents := make(map[string]string, len(paths))
+	results := make(map[string]validator.ValidationResult, len(paths))
+	for _, path := range paths {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		contents[path] = string(data)
+		results[path] = l.validator.ValidateFile(string(data), path)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for path, linkChecks := range l.validator.ValidateLinks(contents) {
+		result := results[path]
+		result.Checks = append(result.Checks, linkChecks...)
+		results[path] = result
+	}
+
+	report := &Report{Files: []FileResult{}}
+	for _, path := range paths {
+		report.Files = append(report.Files, l.fileResult(path, results[path]))
+	}
+	return report, nil
+}
+
+// fileResult converts the result of the validator, resolving the severity and
+// description of each finding
+func (l *Linter) fileResult(path string, result validator.ValidationResult) FileResult {
+	fileResult := FileResult{
+		Path:        path,
+		Ignored:     l.configManager.IsPathIgnored(path),
+		Owner:       result.Owner,
+		FrontMatter: result.FrontMatter,
+		Findings:    []Finding{},
+	}
+	for _, check := range result.Checks {
+		info := l.checks[check.Check]
+		message := info.Description
+		if check.Message != "" {
+			message = check.Message
+		}
+		fileResult.Findings = append(fileResult.Findings, Finding{
+			Check:    check.Check,
+			Severity: Severity(info.Severity),
+			Group:    info.Group,
+			Message:  message,
+			Value:    check.Value,
+			Line:     check.Line,
+		})
+	}
+	return fileResult
+}
@@ -0,0 +1,299 @@
+package frontmatter
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/config"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+const goodPage = `---
+title: Install the command line tool
+description: Learn how to install the command line tool on Linux and macOS.
+linkTitle: Install
+weight: 10
+owner:
+  - https://github.com/orgs/giantswarm/teams/team-phoenix
+last_review_date: 2025-11-01
+user_questions:
+  - How do I install the command line tool?
+---
+
+See [the reference](/reference/).
+`
+
+const referencePage = `---
+title: Command line tool reference
+description: Learn about all commands and flags of the command line tool.
+linkTitle: Reference
+weight: 20
+owner:
+  - https://github.com/orgs/giantswarm/teams/team-phoenix
+last_review_date: 2025-11-01
+user_questions:
+  - Which flags does the command line tool support?
+---
+
+See [the missing page](/missing/).
+`
+
+var testClock = validator.FixedClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+// findingIDs returns the check IDs of the findings of each file with findings
+func findingIDs(report *Report) map[string][]string {
+	ids := make(map[string][]string)
+	for _, file := range report.Files {
+		for _, finding := range file.Findings {
+			ids[file.Path] = append(ids[file.Path], finding.Check)
+		}
+	}
+	return ids
+}
+
+func TestLinter_ValidateFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"content/install.md":        {Data: []byte(goodPage)},
+		"content/reference.md":      {Data: []byte(referencePage)},
+		"content/drafts/page.md":    {Data: []byte("no front matter\n")},
+		"content/images/readme.txt": {Data: []byte("not Markdown")},
+	}
+
+	tests := []struct {
+		name      string
+		opts      []Option
+		want      map[string][]string
+		wantFiles int // Number of files in the report, 3 if not set
+		wantErr   string
+	}{
+		{
+			name: "default profile",
+			want: map[string][]string{
+				"content/drafts/page.md": {validator.NoFrontMatter},
+			},
+		},
+		{
+			name: "config bytes",
+			opts: []Option{WithConfigBytes([]byte(`default_rules:
+  enabled_checks: [NO_FRONT_MATTER, BROKEN_INTERNAL_LINK]
+ignore_paths:
+  - content/drafts/**
+`))},
+			want: map[string][]string{
+				"content/reference.md": {validator.BrokenInternalLink},
+			},
+		},
+		{
+			name: "config struct",
+			opts: []Option{WithConfig(&config.Config{
+				Extends:    config.StringList{"builtin:last-reviewed"},
+				Thresholds: config.Thresholds{ExpirationInDays: config.Range{Max: intPtr(30)}},
+			})},
+			want: map[string][]string{
+				"content/drafts/page.md": {validator.NoFrontMatter},
+			},
+		},
+		{
+			name: "content extensions",
+			opts: []Option{WithConfigBytes([]byte(`default_rules:
+  enabled_checks: [NO_FRONT_MATTER, NO_TRAILING_NEWLINE]
+content_extensions: [.md, .txt]
+`))},
+			want: map[string][]string{
+				"content/drafts/page.md":    {validator.NoFrontMatter},
+				"content/images/readme.txt": {validator.NoTrailingNewline},
+			},
+			wantFiles: 4,
+		},
+		{
+			name: "custom check set",
+			opts: []Option{WithChecks(validator.BrokenInternalLink, validator.NoFrontMatter)},
+			want: map[string][]string{
+				"content/drafts/page.md": {validator.NoFrontMatter},
+				"content/reference.md":   {validator.BrokenInternalLink},
+			},
+		},
+		{
+			name:    "unknown check",
+			opts:    []Option{WithChecks("NO_SUCH_CHECK")},
+			wantErr: `unknown check "NO_SUCH_CHECK"`,
+		},
+		{
+			name:    "several configurations",
+			opts:    []Option{WithConfigBytes([]byte("{}")), WithConfigFiles("frontmatter-validator.yaml")},
+			wantErr: "only one of WithConfig, WithConfigBytes and WithConfigFiles can be used",
+		},
+		{
+			name:    "invalid config bytes",
+			opts:    []Option{WithConfigBytes([]byte("extends: builtin:unknown\n"))},
+			wantErr: `unknown preset "builtin:unknown"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			linter, err := New(append(tt.opts, WithClock(testClock))...)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("New() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			report, err := linter.ValidateFS(context.Background(), fsys)
+			if err != nil {
+				t.Fatalf("ValidateFS() error = %v", err)
+			}
+			wantFiles := tt.wantFiles
+			if wantFiles == 0 {
+				wantFiles = 3
+			}
+			if len(report.Files) != wantFiles {
+				t.Errorf("ValidateFS() returned %d files, want %d", len(report.Files), wantFiles)
+			}
+			if got := findingIDs(report); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findings = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinter_ValidateFile(t *testing.T) {
+	// The page expires after 30 days, which the configured threshold allows,
+	// so it is overdue as of the test clock
+	linter, err := New(
+		WithConfig(&config.Config{
+			DefaultRules: config.RuleSet{EnabledChecks: []string{validator.ReviewTooLongAgo}},
+			Thresholds:   config.Thresholds{ExpirationInDays: config.Range{Max: intPtr(30)}},
+		}),
+		WithClock(testClock),
+	)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	result, err := linter.ValidateFile(context.Background(), "content/install.md", []byte(strings.Replace(goodPage, "weight: 10", "weight: 10\nexpiration_in_days: 30", 1)))
+	if err != nil {
+		t.Fatalf("ValidateFile() error = %v", err)
+	}
+
+	want := []Finding{{
+		Check:    validator.ReviewTooLongAgo,
+		Severity: SeverityWarn,
+		Group:    validator.GroupReview,
+		Message:  validator.GetCheckByID(validator.ReviewTooLongAgo).Description,
+		Value:    "2025-11-01",
+	}}
+	if !reflect.DeepEqual(result.Findings, want) {
+		t.Errorf("Findings = %+v, want %+v", result.Findings, want)
+	}
+	if result.HasFailures() {
+		t.Error("HasFailures() = true, want false for a WARN finding")
+	}
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "frontmatter-validator.yaml")
+	if err := os.WriteFile(configFile, []byte("default_rules:\n  disabled_checks: [NOPE]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		opt     Option
+		wantErr string
+	}{
+		{
+			name:    "unknown check ID",
+			opt:     WithConfigBytes([]byte("default_rules:\n  enabled_checks: [NOPE]\n")),
+			wantErr: `default_rules.enabled_checks[0]: unknown check ID "NOPE"`,
+		},
+		{
+			name:    "misspelled key",
+			opt:     WithConfigBytes([]byte("default_rulez:\n  enabled_checks: [NO_TITLE]\n")),
+			wantErr: `field default_rulez not found`,
+		},
+		{
+			name:    "empty range",
+			opt:     WithConfigBytes([]byte("thresholds:\n  weight: {min: 10, max: 1}\n")),
+			wantErr: "thresholds.weight: min 10 is greater than max 1",
+		},
+		{
+			name: "empty range in struct",
+			opt: WithConfig(&config.Config{
+				Thresholds: config.Thresholds{Weight: config.Range{Min: intPtr(10), Max: intPtr(1)}},
+			}),
+			wantErr: "thresholds.weight: min 10 is greater than max 1",
+		},
+		{
+			name:    "unknown check ID in struct",
+			opt:     WithConfig(&config.Config{DefaultRules: config.RuleSet{EnabledChecks: []string{"NOPE"}}}),
+			wantErr: `unknown check ID "NOPE"`,
+		},
+		{
+			name:    "unknown check ID in file",
+			opt:     WithConfigFiles(configFile),
+			wantErr: `default_rules.disabled_checks[0]: unknown check ID "NOPE"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.opt)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("New() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLinter_Cancelled(t *testing.T) {
+	linter, err := New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := linter.ValidateFS(ctx, fstest.MapFS{"page.md": {Data: []byte(goodPage)}}); !errors.Is(err, context.Canceled) {
+		t.Errorf("ValidateFS() error = %v, want %v", err, context.Canceled)
+	}
+	if _, err := linter.ValidateFile(ctx, "page.md", []byte(goodPage)); !errors.Is(err, context.Canceled) {
+		t.Errorf("ValidateFile() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestReport_HasFailures(t *testing.T) {
+	tests := []struct {
+		name   string
+		report Report
+		want   bool
+	}{
+		{"no files", Report{}, false},
+		{"warnings only", Report{Files: []FileResult{{Findings: []Finding{{Severity: SeverityWarn}}}}}, false},
+		{"failure", Report{Files: []FileResult{{}, {Findings: []Finding{{Severity: SeverityWarn}, {Severity: SeverityFail}}}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.report.HasFailures(); got != tt.want {
+				t.Errorf("HasFailures() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
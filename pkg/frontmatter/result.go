@@ -0,0 +1,84 @@
+package frontmatter
+
+import (
+	"fmt"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// Severity is the severity of a finding
+type Severity string
+
+// Severity levels
+const (
+	SeverityFail Severity = validator.SeverityFail
+	SeverityWarn Severity = validator.SeverityWarn
+)
+
+// Finding is a check that failed for a file
+type Finding struct {
+	Check    string   `json:"check"`
+	Severity Severity `json:"severity"`
+	Group    string   `json:"group"`
+	Message  string   `json:"message"`         // Description of the check, or of the finding
+	Value    any      `json:"value,omitempty"` // Offending value, like a too long title
+	Line     int      `json:"line,omitempty"`  // Line in the file, 1-based
+}
+
+// String formats the finding as "line 3: FAIL LONG_TITLE: message: value"
+func (f Finding) String() string {
+	text := fmt.Sprintf("%s %s: %s", f.Severity, f.Check, f.Message)
+	if f.Value != nil && f.Value != "" {
+		text += fmt.Sprintf(": %v", f.Value)
+	}
+	if f.Line > 0 {
+		text = fmt.Sprintf("line %d: %s", f.Line, text)
+	}
+	return text
+}
+
+// FileResult is the result of validating a single file
+type FileResult struct {
+	Path string `json:"path"`
+	// Ignored is true if the file matches ignore_paths, so no checks ran
+	Ignored     bool                   `json:"ignored,omitempty"`
+	Owner       []string               `json:"owner,omitempty"`
+	Findings    []Finding              `json:"findings"`
+	FrontMatter *validator.FrontMatter `json:"-"`
+}
+
+// HasFailures reports whether any finding has severity FAIL
+func (r FileResult) HasFailures() bool {
+	for _, finding := range r.Findings {
+		if finding.Severity == SeverityFail {
+			return true
+		}
+	}
+	return false
+}
+
+// Report is the result of validating a file system
+type Report struct {
+	// Files holds all Markdown files, including ignored ones and files
+	// without findings, sorted by path
+	Files []FileResult `json:"files"`
+}
+
+// HasFailures reports whether any file has a finding with severity FAIL
+func (r *Report) HasFailures() bool {
+	for _, file := range r.Files {
+		if file.HasFailures() {
+			return true
+		}
+	}
+	return false
+}
+
+// Findings returns the number of findings in all files
+func (r *Report) Findings() int {
+	n := 0
+	for _, file := range r.Files {
+		n += len(file.Findings)
+	}
+	return n
+}
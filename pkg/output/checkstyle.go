@@ -0,0 +1,64 @@
+package output
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// checkstyleReport is the root element of a Checkstyle XML report
+type checkstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+// checkstyleFile holds the findings of a single file
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+// checkstyleError represents a single finding
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// WriteCheckstyle writes the results as Checkstyle XML. FAIL findings have
+// severity "error", WARN findings have severity "warning".
+func (f *Formatter) WriteCheckstyle(w io.Writer, results map[string]validator.ValidationResult) error {
+	report := checkstyleReport{Version: "4.3"}
+
+	for _, filePath := range sortedKeys(results) {
+		file := checkstyleFile{Name: filePath}
+		for _, check := range results[filePath].Checks {
+			severity := "warning"
+			if f.checksMap[check.Check].Severity == validator.SeverityFail {
+				severity = "error"
+			}
+
+			file.Errors = append(file.Errors, checkstyleError{
+				Line:     maxInt(check.Line, 1),
+				Severity: severity,
+				Message:  f.findingText(check),
+				Source:   "frontmatter-validator." + check.Check,
+			})
+		}
+		report.Files = append(report.Files, file)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
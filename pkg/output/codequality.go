@@ -0,0 +1,80 @@
+package output
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// codeQualityIssue is a single finding in GitLab's Code Quality report format
+type codeQualityIssue struct {
+	Description string              `json:"description"`
+	CheckName   string              `json:"check_name"`
+	Fingerprint string              `json:"fingerprint"`
+	Severity    string              `json:"severity"`
+	Location    codeQualityLocation `json:"location"`
+}
+
+// codeQualityLocation points to the file and line of a finding
+type codeQualityLocation struct {
+	Path  string           `json:"path"`
+	Lines codeQualityLines `json:"lines"`
+}
+
+// codeQualityLines holds the first line of a finding
+type codeQualityLines struct {
+	Begin int `json:"begin"`
+}
+
+// WriteCodeQuality writes the results as a GitLab Code Quality report. The
+// fingerprint of each finding is derived from file, check and value, but not
+// from the line, so findings remain stable when lines move.
+func (f *Formatter) WriteCodeQuality(w io.Writer, results map[string]validator.ValidationResult) error {
+	issues := []codeQualityIssue{}
+
+	for _, filePath := range sortedKeys(results) {
+		seen := make(map[string]int)
+		for _, check := range results[filePath].Checks {
+			checkInfo := f.checksMap[check.Check]
+
+			severity := "minor"
+			if checkInfo.Severity == validator.SeverityFail {
+				severity = "major"
+			}
+
+			key := fmt.Sprintf("%s\x00%s\x00%v", filePath, check.Check, check.Value)
+			seen[key]++
+			sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%d", key, seen[key])))
+
+			issues = append(issues, codeQualityIssue{
+				Description: f.findingText(check),
+				CheckName:   check.Check,
+				Fingerprint: hex.EncodeToString(sum[:]),
+				Severity:    severity,
+				Location: codeQualityLocation{
+					Path:  filePath,
+					Lines: codeQualityLines{Begin: maxInt(check.Line, 1)},
+				},
+			})
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(issues)
+}
+
+// sortedKeys returns the file paths of the results in lexical order
+func sortedKeys(results map[string]validator.ValidationResult) []string {
+	keys := make([]string, 0, len(results))
+	for filePath := range results {
+		keys = append(keys, filePath)
+	}
+	sort.Strings(keys)
+	return keys
+}
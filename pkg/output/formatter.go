@@ -3,7 +3,8 @@ package output
 import (
 	"encoding/json"
 	"fmt"
-	"regexp"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/spf13/afero"
@@ -13,98 +14,201 @@ import (
 
 // Formatter handles different output formats
 type Formatter struct {
-	checksMap map[string]validator.Check
+	checksMap    map[string]validator.Check
+	runInfo      RunInfo
+	reviewIssues *ReviewIssues
+	ownerMapping OwnerMapping
+	maxFindings  int
+	color        bool
+	style        string
+}
+
+// Styles of the human-readable output
+const (
+	StyleFull  = "full"
+	StyleShort = "short"
+)
+
+// WithColor enables or disables ANSI colors in the human-readable output.
+// Colors are enabled by default.
+func WithColor(enabled bool) Option {
+	return func(f *Formatter) {
+		f.color = enabled
+	}
+}
+
+// WithStyle sets the style of the human-readable output, StyleFull or
+// StyleShort
+func WithStyle(style string) Option {
+	return func(f *Formatter) {
+		f.style = style
+	}
+}
+
+// Option configures a Formatter
+type Option func(*Formatter)
+
+// WithRunInfo sets the run metadata included in the JSON report
+func WithRunInfo(info RunInfo) Option {
+	return func(f *Formatter) {
+		f.runInfo = info
+	}
 }
 
 // New creates a new Formatter instance
-func New() *Formatter {
+func New(opts ...Option) *Formatter {
 	checksMap := make(map[string]validator.Check)
 	for _, check := range validator.GetChecks() {
 		checksMap[check.ID] = check
 	}
 
-	return &Formatter{
-		checksMap: checksMap,
+	// The default templates are known to parse
+	reviewIssues, _ := NewReviewIssues("", "", "")
+
+	f := &Formatter{
+		checksMap:    checksMap,
+		reviewIssues: reviewIssues,
+		ownerMapping: DefaultOwnerMapping,
+		color:        true,
+		style:        StyleFull,
+	}
+	for _, opt := range opts {
+		opt(f)
 	}
+	return f
 }
 
 // PrintStdout prints validation results to stdout with colored output
 func (f *Formatter) PrintStdout(results map[string]validator.ValidationResult) {
+	_ = f.WriteText(os.Stdout, results)
+}
+
+// WriteText writes validation results in the human-readable style, or one
+// line per finding in the short style
+func (f *Formatter) WriteText(w io.Writer, results map[string]validator.ValidationResult) error {
+	if f.style == StyleShort {
+		return f.writeShort(w, results)
+	}
+
+	var b strings.Builder
 	nFails := 0
 	nWarnings := 0
 
-	for filePath, result := range results {
-		fmt.Printf("\n%s\n", filePath)
+	for _, filePath := range sortedKeys(results) {
+		fmt.Fprintf(&b, "\n%s\n", filePath)
 
-		var fails []validator.CheckResult
-		var warnings []validator.CheckResult
-
-		for _, check := range result.Checks {
-			if f.checksMap[check.Check].Severity == validator.SeverityFail {
-				fails = append(fails, check)
-				nFails++
-			} else {
-				warnings = append(warnings, check)
-				nWarnings++
-			}
-		}
+		fails, warnings := f.splitBySeverity(results[filePath].Checks)
+		nFails += len(fails)
+		nWarnings += len(warnings)
 
 		// Print failures first
 		for _, check := range fails {
-			f.printCheckResult(check, validator.SeverityFail)
+			b.WriteString(f.formatCheckResult(check, validator.SeverityFail))
 		}
 
 		// Then warnings
 		for _, check := range warnings {
-			f.printCheckResult(check, validator.SeverityWarn)
+			b.WriteString(f.formatCheckResult(check, validator.SeverityWarn))
 		}
 	}
 
-	fmt.Println()
+	b.WriteString("\n")
 	if nFails > 0 {
-		fmt.Printf("Found %d critical problem%s, marked with %s.\n", nFails, pluralize(nFails), f.colorSeverity(validator.SeverityFail))
+		fmt.Fprintf(&b, "Found %d critical problem%s, marked with %s.\n", nFails, pluralize(nFails), f.colorSeverity(validator.SeverityFail))
 	}
 	if nWarnings > 0 {
-		fmt.Printf("Found %d less severe problem%s, marked with %s.\n", nWarnings, pluralize(nWarnings), f.colorSeverity(validator.SeverityWarn))
+		fmt.Fprintf(&b, "Found %d less severe problem%s, marked with %s.\n", nWarnings, pluralize(nWarnings), f.colorSeverity(validator.SeverityWarn))
 	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
 }
 
-// PrintJSON prints validation results as JSON for issue tracking
+// writeShort writes one "path:line: SEVERITY CHECK message" line per finding,
+// as parsed by editors into quickfix lists
+func (f *Formatter) writeShort(w io.Writer, results map[string]validator.ValidationResult) error {
+	var b strings.Builder
+
+	for _, filePath := range sortedKeys(results) {
+		fails, warnings := f.splitBySeverity(results[filePath].Checks)
+		for _, check := range append(fails, warnings...) {
+			checkInfo := f.checksMap[check.Check]
+
+			message := f.description(check)
+			if checkInfo.HasValue && check.Value != nil && check.Value != "" {
+				message += ": " + strings.ReplaceAll(fmt.Sprintf("%v", check.Value), "\n", " ")
+			}
+
+			fmt.Fprintf(&b, "%s:%d: %s %s %s\n",
+				filePath,
+				maxInt(check.Line, 1),
+				f.colorSeverity(checkInfo.Severity),
+				f.colorHeadline(check.Check),
+				message)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// PrintJSON prints the review issues for findings with a title to stdout.
+//
+// Deprecated: Use WriteReviewIssues, or WriteJSON for a report of all findings.
 func (f *Formatter) PrintJSON(results map[string]validator.ValidationResult) {
+	_ = f.WriteReviewIssues(os.Stdout, results)
+}
+
+// WriteReviewIssues writes one issue per finding with a title as JSON, to be
+// created in an issue tracker. Currently these are the findings of the
+// REVIEW_TOO_LONG_AGO and INVALID_LAST_REVIEW_DATE checks.
+func (f *Formatter) WriteReviewIssues(w io.Writer, results map[string]validator.ValidationResult) error {
 	var output []validator.JSONOutput
 
 	for filePath, result := range results {
 		for _, check := range result.Checks {
-			title := check.Title
-			if title == "" {
+			if check.Title == "" {
 				continue
 			}
 
-			description := f.checksMap[check.Check].Description
-			var owners []string
-
-			if len(check.Owner) > 0 {
-				for _, owner := range check.Owner {
-					re := regexp.MustCompile(`/.*\/([^\/]+)\/?$`)
-					matches := re.FindStringSubmatch(owner)
-					if len(matches) > 1 {
-						teamName := matches[1]
-						teamLabel := strings.ReplaceAll(teamName, "-", "/")
-						owners = append(owners, teamLabel)
-					}
-				}
+			owners := check.Owner
+			if len(owners) == 0 {
+				owners = result.Owner
+			}
+			targets := f.ownerMapping.Resolve(owners)
+
+			title, message, err := f.reviewIssues.render(ReviewIssueData{
+				File:      filePath,
+				URL:       f.reviewIssues.URL(filePath),
+				Title:     check.Title,
+				Page:      result.FrontMatter,
+				Check:     f.checksMap[check.Check],
+				Value:     check.Value,
+				Owners:    owners,
+				Labels:    targets.Labels,
+				Assignees: targets.Assignees,
+				Projects:  targets.Projects,
+			})
+			if err != nil {
+				return err
 			}
 
 			output = append(output, validator.JSONOutput{
-				Title:   fmt.Sprintf("Doc entry \"%s\" needs to be reviewed", title),
-				Message: fmt.Sprintf("%s for [this document](%s%s).", description, validator.DocsHost, filePath),
-				Owner:   owners,
+				Title:     title,
+				Message:   message,
+				Owner:     targets.Labels,
+				Assignees: targets.Assignees,
+				Projects:  targets.Projects,
 			})
 		}
 	}
 
-	jsonBytes, _ := json.Marshal(output)
-	fmt.Println(string(jsonBytes))
+	jsonBytes, err := json.Marshal(output)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(jsonBytes))
+	return err
 }
 
 // DumpAnnotations creates GitHub Actions annotations file using the OS filesystem
@@ -114,17 +218,21 @@ func (f *Formatter) DumpAnnotations(results map[string]validator.ValidationResul
 
 // DumpAnnotationsToFS creates GitHub Actions annotations file using the provided filesystem
 func (f *Formatter) DumpAnnotationsToFS(fs afero.Fs, filename string, results map[string]validator.ValidationResult) error {
-	annotations := f.buildAnnotations(results)
-
 	file, err := fs.Create(filename)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
-	encoder := json.NewEncoder(file)
+	return f.WriteAnnotations(file, results)
+}
+
+// WriteAnnotations writes GitHub Actions annotations as used by the
+// annotations-action
+func (f *Formatter) WriteAnnotations(w io.Writer, results map[string]validator.ValidationResult) error {
+	encoder := json.NewEncoder(w)
 	encoder.SetIndent("", "  ")
-	return encoder.Encode(annotations)
+	return encoder.Encode(f.buildAnnotations(results))
 }
 
 // buildAnnotations creates the annotations data structure from validation results
@@ -156,7 +264,7 @@ func (f *Formatter) buildAnnotations(results map[string]validator.ValidationResu
 				nWarnings++
 			}
 
-			message.WriteString(fmt.Sprintf("%s - %s\n", checkInfo.Severity, checkInfo.Description))
+			message.WriteString(fmt.Sprintf("%s - %s\n", checkInfo.Severity, f.description(check)))
 			if checkInfo.HasValue && check.Value != nil && check.Value != "" {
 				message.WriteString(fmt.Sprintf(": %v\n", check.Value))
 			}
@@ -185,23 +293,39 @@ func (f *Formatter) buildAnnotations(results map[string]validator.ValidationResu
 	return annotations
 }
 
-// printCheckResult prints a single check result with formatting
-func (f *Formatter) printCheckResult(check validator.CheckResult, severity string) {
+// description returns the description of a finding: its own message, or else
+// the description of its check
+func (f *Formatter) description(check validator.CheckResult) string {
+	if check.Message != "" {
+		return check.Message
+	}
+	return f.checksMap[check.Check].Description
+}
+
+// formatCheckResult formats a single check result as a line
+func (f *Formatter) formatCheckResult(check validator.CheckResult, severity string) string {
 	checkInfo := f.checksMap[check.Check]
 	line := fmt.Sprintf(" - %s - %s - %s",
 		f.colorSeverity(severity),
 		f.colorHeadline(check.Check),
-		checkInfo.Description)
+		f.description(check))
 
 	if checkInfo.HasValue && check.Value != nil && check.Value != "" {
 		line += fmt.Sprintf(": %s", f.colorLiteral(fmt.Sprintf("%v", check.Value)))
 	}
 
-	fmt.Println(line)
+	if check.Line > 0 {
+		line += fmt.Sprintf(" (line %d)", check.Line)
+	}
+
+	return line + "\n"
 }
 
-// Color functions (simplified - could be enhanced with actual ANSI colors)
+// Color functions, which return the text unchanged when color is disabled
 func (f *Formatter) colorSeverity(severity string) string {
+	if !f.color {
+		return severity
+	}
 	switch severity {
 	case validator.SeverityFail:
 		return fmt.Sprintf("\033[1;31m%s\033[0m", severity) // Bold red
@@ -213,10 +337,16 @@ func (f *Formatter) colorSeverity(severity string) string {
 }
 
 func (f *Formatter) colorHeadline(text string) string {
+	if !f.color {
+		return text
+	}
 	return fmt.Sprintf("\033[37m%s\033[0m", text) // White
 }
 
 func (f *Formatter) colorLiteral(text string) string {
+	if !f.color {
+		return text
+	}
 	return fmt.Sprintf("\033[36m%s\033[0m", text) // Cyan
 }
 
@@ -710,3 +710,71 @@ func TestPrintStdout(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteText(t *testing.T) {
+	results := map[string]validator.ValidationResult{
+		"docs/b.md": {
+			Checks: []validator.CheckResult{
+				{Check: validator.NoWeight},
+				{Check: validator.LongTitle, Value: "A very long title", Line: 2},
+			},
+		},
+		"docs/a.md": {
+			Checks: []validator.CheckResult{
+				{Check: validator.NoDescription},
+				{Check: validator.InvalidWeight, Value: 200000, Message: "The weight value is outside the allowed range of 0 to 1000", Line: 4},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		opts        []Option
+		expected    string
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:     "colored by default",
+			contains: []string{"\033[1;31mFAIL\033[0m"},
+		},
+		{
+			name:        "without color",
+			opts:        []Option{WithColor(false)},
+			contains:    []string{"\ndocs/a.md\n - FAIL - NO_DESCRIPTION - Each page should have a description\n", "Found 3 critical problems, marked with FAIL."},
+			notContains: []string{"\033["},
+		},
+		{
+			name: "short style",
+			opts: []Option{WithColor(false), WithStyle(StyleShort)},
+			expected: "docs/a.md:1: FAIL NO_DESCRIPTION Each page should have a description\n" +
+				"docs/a.md:4: FAIL INVALID_WEIGHT The weight value is outside the allowed range of 0 to 1000: 200000\n" +
+				"docs/b.md:2: FAIL LONG_TITLE The title should be less than 100 characters: A very long title\n" +
+				"docs/b.md:1: WARN NO_WEIGHT The page should have a weight attribute, to control the sort order\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := New(tt.opts...).WriteText(&buf, results); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			out := buf.String()
+
+			if tt.expected != "" && out != tt.expected {
+				t.Errorf("Expected:\n%s\ngot:\n%s", tt.expected, out)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(out, want) {
+					t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+				}
+			}
+			for _, unwanted := range tt.notContains {
+				if strings.Contains(out, unwanted) {
+					t.Errorf("Expected output not to contain %q, got:\n%s", unwanted, out)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// WriteGitHub writes the results as GitHub Actions workflow commands, one
+// ::error or ::warning command per finding. The runner turns these into
+// annotations without any further action in the workflow.
+func (f *Formatter) WriteGitHub(w io.Writer, results map[string]validator.ValidationResult) error {
+	for _, filePath := range sortedKeys(results) {
+		for _, check := range results[filePath].Checks {
+			checkInfo := f.checksMap[check.Check]
+
+			command := "warning"
+			if checkInfo.Severity == validator.SeverityFail {
+				command = "error"
+			}
+
+			line := maxInt(check.Line, 1)
+			endLine := maxInt(check.EndLine, line)
+
+			_, err := fmt.Fprintf(w, "::%s file=%s,line=%d,endLine=%d,title=%s::%s\n",
+				command,
+				escapeProperty(filePath),
+				line,
+				endLine,
+				escapeProperty(fmt.Sprintf("%s %s", checkInfo.Severity, check.Check)),
+				escapeData(f.findingText(check)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteStepSummary writes a Markdown summary of the results, as shown on the
+// run page when written to the file named by GITHUB_STEP_SUMMARY
+func (f *Formatter) WriteStepSummary(w io.Writer, results map[string]validator.ValidationResult) error {
+	nFails := 0
+	nWarnings := 0
+	var rows strings.Builder
+
+	for _, filePath := range sortedKeys(results) {
+		for _, check := range results[filePath].Checks {
+			checkInfo := f.checksMap[check.Check]
+			if checkInfo.Severity == validator.SeverityFail {
+				nFails++
+			} else {
+				nWarnings++
+			}
+
+			line := ""
+			if check.Line > 0 {
+				line = fmt.Sprintf("%d", check.Line)
+			}
+
+			detail := f.description(check)
+			if checkInfo.HasValue && check.Value != nil && check.Value != "" {
+				detail += fmt.Sprintf(": %v", check.Value)
+			}
+
+			fmt.Fprintf(&rows, "| %s | `%s` | %s | %s | %s |\n",
+				checkInfo.Severity,
+				check.Check,
+				escapeTableCell(filePath),
+				line,
+				escapeTableCell(detail))
+		}
+	}
+
+	var summary strings.Builder
+	summary.WriteString("## Frontmatter validation\n\n")
+	if nFails+nWarnings == 0 {
+		summary.WriteString("No problems found.\n")
+	} else {
+		fmt.Fprintf(&summary, "Found %d critical problem%s and %d less severe problem%s in %d file%s.\n\n",
+			nFails, pluralize(nFails), nWarnings, pluralize(nWarnings), len(results), pluralize(len(results)))
+		summary.WriteString("| Severity | Check | File | Line | Details |\n")
+		summary.WriteString("| --- | --- | --- | --- | --- |\n")
+		summary.WriteString(rows.String())
+	}
+
+	_, err := io.WriteString(w, summary.String())
+	return err
+}
+
+// escapeData escapes the message of a workflow command
+func escapeData(s string) string {
+	s = strings.ReplaceAll(s, "%", "%25")
+	s = strings.ReplaceAll(s, "\r", "%0D")
+	s = strings.ReplaceAll(s, "\n", "%0A")
+	return s
+}
+
+// escapeProperty escapes a property value of a workflow command
+func escapeProperty(s string) string {
+	s = escapeData(s)
+	s = strings.ReplaceAll(s, ":", "%3A")
+	s = strings.ReplaceAll(s, ",", "%2C")
+	return s
+}
+
+// escapeTableCell makes a value safe for use in a Markdown table cell
+func escapeTableCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	s = strings.ReplaceAll(s, "\r", " ")
+	s = strings.ReplaceAll(s, "\n", " ")
+	return s
+}
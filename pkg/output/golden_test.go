@@ -0,0 +1,150 @@
+package output
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+var update = flag.Bool("update", false, "Update golden files in testdata")
+
+// goldenResults covers FAIL and WARN findings, values, lines and files
+// without a line number
+var goldenResults = map[string]validator.ValidationResult{
+	"src/content/docs/b.md": {
+		Owner: []string{"https://github.com/orgs/giantswarm/teams/team-phoenix"},
+		Checks: []validator.CheckResult{
+			{Check: validator.LongDescription, Value: 312, Line: 3},
+			{Check: validator.NoWeight},
+		},
+	},
+	"src/content/docs/a.md": {
+		Checks: []validator.CheckResult{
+			{Check: validator.NoTitle},
+			{Check: validator.BrokenInternalLink, Value: `"/docs/missing/" <&>`, Line: 12},
+			{Check: validator.BrokenInternalLink, Value: `"/docs/missing/" <&>`, Line: 20},
+		},
+	},
+}
+
+// escapingFiles lists the files of escapingResults
+var escapingFiles = []string{"src/content/docs/a.md", "src/content/docs/b.md", "src/content/docs/c,d:e.md"}
+
+// escapingResults adds characters that the GitHub, Markdown, JSON and HTML
+// formats must escape, in values and in file names
+var escapingResults = map[string]validator.ValidationResult{
+	"src/content/docs/b.md": goldenResults["src/content/docs/b.md"],
+	"src/content/docs/c,d:e.md": {
+		Checks: []validator.CheckResult{
+			{Check: validator.NoDescription},
+		},
+	},
+	"src/content/docs/a.md": {
+		Checks: []validator.CheckResult{
+			{Check: validator.NoTitle},
+			{Check: validator.BrokenInternalLink, Value: "\"/docs/missing/\" <&> 100%|\n", Line: 12},
+			{Check: validator.BrokenInternalLink, Value: "\"/docs/missing/\" <&> 100%|\n", Line: 20},
+		},
+	},
+}
+
+func TestWriteGolden(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		write  func(*Formatter, io.Writer) error
+	}{
+		{
+			name:   "gitlab code quality",
+			golden: "codequality.golden.json",
+			write: func(f *Formatter, w io.Writer) error {
+				return f.WriteCodeQuality(w, goldenResults)
+			},
+		},
+		{
+			name:   "checkstyle",
+			golden: "checkstyle.golden.xml",
+			write: func(f *Formatter, w io.Writer) error {
+				return f.WriteCheckstyle(w, goldenResults)
+			},
+		},
+		{
+			name:   "json",
+			golden: "json.golden.json",
+			write: func(f *Formatter, w io.Writer) error {
+				return f.WriteJSON(w, append(escapingFiles, "src/content/docs/z.md"), escapingResults)
+			},
+		},
+		{
+			name:   "html",
+			golden: "report.golden.html",
+			write: func(f *Formatter, w io.Writer) error {
+				return f.WriteHTML(w, escapingFiles, escapingResults)
+			},
+		},
+		{
+			name:   "sarif",
+			golden: "sarif.golden.json",
+			write: func(f *Formatter, w io.Writer) error {
+				return f.WriteSARIF(w, goldenResults)
+			},
+		},
+		{
+			name:   "github workflow commands",
+			golden: "github.golden.txt",
+			write: func(f *Formatter, w io.Writer) error {
+				return f.WriteGitHub(w, escapingResults)
+			},
+		},
+		{
+			name:   "github step summary",
+			golden: "github-summary.golden.md",
+			write: func(f *Formatter, w io.Writer) error {
+				return f.WriteStepSummary(w, escapingResults)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			formatter := New(WithRunInfo(RunInfo{
+				Tool:        "frontmatter-validator",
+				ToolVersion: "1.2.3",
+				Date:        "2026-03-15",
+				Path:        "src/content",
+			}))
+			if err := tt.write(formatter, &buf); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			assertGolden(t, tt.golden, buf.Bytes())
+		})
+	}
+}
+
+// assertGolden compares output with a golden file in testdata. Run the tests
+// with -update to rewrite the golden files.
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatalf("Failed to update golden file: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read golden file: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Output does not match %s, run with -update to refresh it.\nGot:\n%s\nWant:\n%s", path, got, want)
+	}
+}
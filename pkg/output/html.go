@@ -0,0 +1,111 @@
+package output
+
+import (
+	_ "embed"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/report"
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+//go:embed templates/report.html.tmpl
+var htmlReportTemplate string
+
+var htmlReport = template.Must(template.New("report").Parse(htmlReportTemplate))
+
+// htmlReportData is passed to the HTML report template
+type htmlReportData struct {
+	Run       RunInfo
+	Summary   JSONSummary
+	ByCheck   []htmlCount
+	BySection []htmlCount
+	Owners    []string
+	Findings  []htmlFinding
+}
+
+// htmlCount is a bar in a summary chart
+type htmlCount struct {
+	Name     string
+	Severity string
+	Count    int
+	Percent  int
+}
+
+// htmlFinding is a row in the findings table
+type htmlFinding struct {
+	JSONFinding
+	URL      string
+	Teams    []string
+	OwnerKey string
+}
+
+// WriteHTML writes a self-contained HTML report with summary charts per check
+// and per content section, and a sortable table of all findings that can be
+// filtered by owner. Files link to the review issue link base.
+func (f *Formatter) WriteHTML(w io.Writer, files []string, results map[string]validator.ValidationResult) error {
+	jsonReport := f.buildJSONReport(files, results)
+
+	data := htmlReportData{
+		Run:     jsonReport.Run,
+		Summary: jsonReport.Summary,
+	}
+
+	byCheck := make(map[string]int)
+	bySection := make(map[string]int)
+	owners := make(map[string]bool)
+
+	for _, finding := range jsonReport.Findings {
+		byCheck[finding.Check]++
+		bySection[contentSection(finding.File)]++
+
+		row := htmlFinding{
+			JSONFinding: finding,
+			URL:         f.reviewIssues.URL(finding.File),
+		}
+		for _, owner := range finding.Owners {
+			team := report.TeamName(owner)
+			row.Teams = append(row.Teams, team)
+			owners[team] = true
+		}
+		if len(row.Teams) == 0 {
+			owners[report.Unowned] = true
+			row.OwnerKey = report.Unowned
+		} else {
+			row.OwnerKey = strings.Join(row.Teams, " ")
+		}
+		data.Findings = append(data.Findings, row)
+	}
+
+	for check, count := range byCheck {
+		data.ByCheck = append(data.ByCheck, htmlCount{Name: check, Severity: f.checksMap[check].Severity, Count: count})
+	}
+	for section, count := range bySection {
+		data.BySection = append(data.BySection, htmlCount{Name: section, Count: count})
+	}
+	scaleCounts(data.ByCheck)
+	scaleCounts(data.BySection)
+
+	for owner := range owners {
+		data.Owners = append(data.Owners, owner)
+	}
+	sort.Strings(data.Owners)
+
+	return htmlReport.Execute(w, data)
+}
+
+// scaleCounts sorts counts in descending order and sets each bar's width
+// relative to the largest count
+func scaleCounts(counts []htmlCount) {
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Name < counts[j].Name
+	})
+	for i := range counts {
+		counts[i].Percent = counts[i].Count * 100 / counts[0].Count
+	}
+}
@@ -0,0 +1,128 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// JSONReportVersion is the version of the JSON report format. It is increased
+// on changes that are not backward compatible.
+const JSONReportVersion = 1
+
+// JSONReport is the machine-readable report of all findings
+type JSONReport struct {
+	Version  int           `json:"version"`
+	Run      RunInfo       `json:"run"`
+	Summary  JSONSummary   `json:"summary"`
+	Findings []JSONFinding `json:"findings"`
+}
+
+// RunInfo describes the validation run
+type RunInfo struct {
+	Tool        string `json:"tool"`
+	ToolVersion string `json:"tool_version,omitempty"`
+	// Date is the date the date checks were evaluated for, as YYYY-MM-DD
+	Date       string `json:"date,omitempty"`
+	Path       string `json:"path,omitempty"`
+	ConfigFile string `json:"config_file,omitempty"`
+}
+
+// JSONSummary holds the counts of a validation run
+type JSONSummary struct {
+	Files             int `json:"files"`
+	FilesWithFindings int `json:"files_with_findings"`
+	Findings          int `json:"findings"`
+	Fail              int `json:"fail"`
+	Warn              int `json:"warn"`
+}
+
+// JSONFinding is a single finding
+type JSONFinding struct {
+	File        string      `json:"file"`
+	Line        int         `json:"line,omitempty"`
+	EndLine     int         `json:"end_line,omitempty"`
+	Check       string      `json:"check"`
+	Severity    string      `json:"severity"`
+	Value       interface{} `json:"value,omitempty"`
+	Description string      `json:"description"`
+	Owners      []string    `json:"owners,omitempty"`
+}
+
+// WriteJSON writes a versioned JSON report with every finding, run metadata
+// and summary counts. Files lists all validated files, including those
+// without findings.
+func (f *Formatter) WriteJSON(w io.Writer, files []string, results map[string]validator.ValidationResult) error {
+	report := f.buildJSONReport(files, results)
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// buildJSONReport creates the JSON report data structure from validation results
+func (f *Formatter) buildJSONReport(files []string, results map[string]validator.ValidationResult) JSONReport {
+	report := JSONReport{
+		Version:  JSONReportVersion,
+		Run:      f.runInfo,
+		Findings: []JSONFinding{},
+	}
+	if report.Run.Tool == "" {
+		report.Run.Tool = "frontmatter-validator"
+	}
+
+	validated := make(map[string]bool)
+	for _, filePath := range files {
+		validated[filePath] = true
+	}
+
+	for _, filePath := range sortedKeys(results) {
+		result := results[filePath]
+		validated[filePath] = true
+		if len(result.Checks) > 0 {
+			report.Summary.FilesWithFindings++
+		}
+
+		for _, check := range result.Checks {
+			checkInfo := f.checksMap[check.Check]
+
+			finding := JSONFinding{
+				File:        filePath,
+				Line:        check.Line,
+				EndLine:     check.EndLine,
+				Check:       check.Check,
+				Severity:    checkInfo.Severity,
+				Description: f.description(check),
+				Owners:      result.Owner,
+			}
+			if check.Value != "" {
+				finding.Value = check.Value
+			}
+			if len(check.Owner) > 0 {
+				finding.Owners = check.Owner
+			}
+
+			if checkInfo.Severity == validator.SeverityFail {
+				report.Summary.Fail++
+			} else {
+				report.Summary.Warn++
+			}
+			report.Findings = append(report.Findings, finding)
+		}
+	}
+
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		a, b := report.Findings[i], report.Findings[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line
+	})
+
+	report.Summary.Files = len(validated)
+	report.Summary.Findings = len(report.Findings)
+
+	return report
+}
@@ -0,0 +1,166 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// How JUnit output reports findings with severity WARN
+const (
+	JUnitWarningsSystemOut = "system-out"
+	JUnitWarningsSkipped   = "skipped"
+)
+
+// junitTestSuites is the root element of a JUnit XML report
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite groups the files of one top-level content section
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase represents a single validated file
+type junitTestCase struct {
+	Name      string         `xml:"name,attr"`
+	ClassName string         `xml:"classname,attr"`
+	File      string         `xml:"file,attr"`
+	Failures  []junitFailure `xml:"failure"`
+	Skipped   *junitSkipped  `xml:"skipped"`
+	SystemOut string         `xml:"system-out,omitempty"`
+}
+
+// junitFailure represents a finding with severity FAIL
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// junitSkipped marks a file that only has findings with severity WARN
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnit writes the results as JUnit XML. Every validated file becomes a
+// test case, including files without findings, and test suites are grouped by
+// top-level content section. Each FAIL finding is reported as a failure. WARN
+// findings are written to system-out, or with warnings set to
+// JUnitWarningsSkipped, files with only WARN findings are marked as skipped.
+func (f *Formatter) WriteJUnit(w io.Writer, files []string, results map[string]validator.ValidationResult, warnings string) error {
+	suites := make(map[string]*junitTestSuite)
+	report := junitTestSuites{Name: "frontmatter-validator"}
+
+	sortedFiles := append([]string(nil), files...)
+	sort.Strings(sortedFiles)
+
+	for _, filePath := range sortedFiles {
+		section := contentSection(filePath)
+		suite := suites[section]
+		if suite == nil {
+			suite = &junitTestSuite{Name: section}
+			suites[section] = suite
+		}
+
+		testCase := junitTestCase{
+			Name:      filePath,
+			ClassName: section,
+			File:      filePath,
+		}
+
+		var warnLines []string
+		for _, check := range results[filePath].Checks {
+			checkInfo := f.checksMap[check.Check]
+			if checkInfo.Severity == validator.SeverityFail {
+				testCase.Failures = append(testCase.Failures, junitFailure{
+					Message: fmt.Sprintf("%s: %s", check.Check, f.description(check)),
+					Type:    check.Check,
+					Text:    f.findingText(check),
+				})
+			} else {
+				warnLines = append(warnLines, fmt.Sprintf("%s - %s", validator.SeverityWarn, f.findingText(check)))
+			}
+		}
+
+		if len(warnLines) > 0 {
+			if warnings == JUnitWarningsSkipped && len(testCase.Failures) == 0 {
+				testCase.Skipped = &junitSkipped{
+					Message: fmt.Sprintf("%d less severe problem%s", len(warnLines), pluralize(len(warnLines))),
+				}
+			}
+			testCase.SystemOut = strings.Join(warnLines, "\n")
+		}
+
+		suite.Tests++
+		if len(testCase.Failures) > 0 {
+			suite.Failures++
+		}
+		if testCase.Skipped != nil {
+			suite.Skipped++
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	sectionNames := make([]string, 0, len(suites))
+	for section := range suites {
+		sectionNames = append(sectionNames, section)
+	}
+	sort.Strings(sectionNames)
+
+	for _, section := range sectionNames {
+		suite := suites[section]
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Skipped += suite.Skipped
+		report.Suites = append(report.Suites, *suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// findingText describes a finding in a single line, including value and line
+func (f *Formatter) findingText(check validator.CheckResult) string {
+	checkInfo := f.checksMap[check.Check]
+	text := fmt.Sprintf("%s - %s", check.Check, f.description(check))
+	if checkInfo.HasValue && check.Value != nil && check.Value != "" {
+		text += fmt.Sprintf(": %v", check.Value)
+	}
+	if check.Line > 0 {
+		text += fmt.Sprintf(" (line %d)", check.Line)
+	}
+	return text
+}
+
+// contentSection returns the top-level content section of a file, like "docs"
+// for "src/content/docs/page.md". Files at the top level belong to section ".".
+func contentSection(filePath string) string {
+	contentPath := validator.ContentPath(filePath)
+	if i := strings.Index(contentPath, "/"); i > 0 {
+		return contentPath[:i]
+	}
+	return "."
+}
@@ -0,0 +1,93 @@
+package output
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+func TestWriteJUnit(t *testing.T) {
+	files := []string{
+		"src/content/docs/b.md",
+		"src/content/docs/a.md",
+		"src/content/tutorials/c.md",
+		"README.md",
+	}
+	results := map[string]validator.ValidationResult{
+		"src/content/docs/a.md": {
+			Checks: []validator.CheckResult{
+				{Check: validator.NoTitle, Line: 1},
+				{Check: validator.NoWeight},
+			},
+		},
+		"src/content/tutorials/c.md": {
+			Checks: []validator.CheckResult{
+				{Check: validator.NoLinkTitle},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		warnings    string
+		wantSkipped int
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:     "warnings as system-out",
+			warnings: JUnitWarningsSystemOut,
+			contains: []string{
+				`<testsuites name="frontmatter-validator" tests="4" failures="1" skipped="0">`,
+				`<testsuite name="." tests="1" failures="0" skipped="0">`,
+				`<testsuite name="docs" tests="2" failures="1" skipped="0">`,
+				`<testcase name="src/content/docs/a.md" classname="docs" file="src/content/docs/a.md">`,
+				`<failure message="NO_TITLE: The page should have a title" type="NO_TITLE">NO_TITLE - The page should have a title (line 1)</failure>`,
+				`<system-out>WARN - NO_WEIGHT - The page should have a weight attribute, to control the sort order</system-out>`,
+				`<testcase name="src/content/docs/b.md" classname="docs" file="src/content/docs/b.md"></testcase>`,
+			},
+			notContains: []string{"<skipped"},
+		},
+		{
+			name:        "warnings as skipped",
+			warnings:    JUnitWarningsSkipped,
+			wantSkipped: 1,
+			contains: []string{
+				`<testsuite name="tutorials" tests="1" failures="0" skipped="1">`,
+				`<skipped message="1 less severe problem"></skipped>`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := New().WriteJUnit(&buf, files, results, tt.warnings); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			out := buf.String()
+
+			var decoded junitTestSuites
+			if err := xml.Unmarshal(buf.Bytes(), &decoded); err != nil {
+				t.Fatalf("Output is not valid XML: %v", err)
+			}
+			if decoded.Skipped != tt.wantSkipped {
+				t.Errorf("Expected %d skipped, got %d", tt.wantSkipped, decoded.Skipped)
+			}
+
+			for _, want := range tt.contains {
+				if !strings.Contains(out, want) {
+					t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+				}
+			}
+			for _, unwanted := range tt.notContains {
+				if strings.Contains(out, unwanted) {
+					t.Errorf("Expected output not to contain %q, got:\n%s", unwanted, out)
+				}
+			}
+		})
+	}
+}
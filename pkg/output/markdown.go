@@ -0,0 +1,138 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// markdownCollapseThreshold is the number of findings from which the findings
+// of each file are collapsed into a <details> block
+const markdownCollapseThreshold = 10
+
+// WithMaxFindings limits the number of findings listed in the Markdown
+// report. Zero or less lists all findings.
+func WithMaxFindings(n int) Option {
+	return func(f *Formatter) {
+		f.maxFindings = n
+	}
+}
+
+// WriteMarkdown writes a compact GitHub-flavored Markdown report, suitable
+// for pull request comments. It shows totals by severity and a table of
+// findings per file, FAIL findings first. With many findings, each file's
+// table is collapsed into a <details> block. Findings beyond the limit set
+// via WithMaxFindings are summarized in a final line.
+func (f *Formatter) WriteMarkdown(w io.Writer, results map[string]validator.ValidationResult) error {
+	var b strings.Builder
+	nFails, nWarnings := f.countSeverities(results)
+	total := nFails + nWarnings
+
+	b.WriteString("## Frontmatter validation\n\n")
+	if total == 0 {
+		b.WriteString("No problems found.\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	fmt.Fprintf(&b, "| Severity | Findings |\n| --- | ---: |\n| %s | %d |\n| %s | %d |\n\n",
+		validator.SeverityFail, nFails, validator.SeverityWarn, nWarnings)
+	fmt.Fprintf(&b, "%d finding%s in %d file%s.\n", total, pluralize(total), len(results), pluralize(len(results)))
+
+	collapse := total >= markdownCollapseThreshold
+	listed := 0
+
+	for _, filePath := range sortedKeys(results) {
+		if f.maxFindings > 0 && listed >= f.maxFindings {
+			break
+		}
+
+		fails, warnings := f.splitBySeverity(results[filePath].Checks)
+		checks := append(fails, warnings...)
+		if len(checks) == 0 {
+			continue
+		}
+		if f.maxFindings > 0 && listed+len(checks) > f.maxFindings {
+			checks = checks[:f.maxFindings-listed]
+		}
+		listed += len(checks)
+
+		if collapse {
+			fmt.Fprintf(&b, "\n<details>\n<summary><code>%s</code> (%s)</summary>\n\n", escapeHTML(filePath), severityCounts(len(fails), len(warnings)))
+		} else {
+			fmt.Fprintf(&b, "\n#### `%s`\n\n", strings.ReplaceAll(filePath, "`", "'"))
+		}
+
+		b.WriteString("| Severity | Check | Line | Details |\n| --- | --- | ---: | --- |\n")
+		for _, check := range checks {
+			checkInfo := f.checksMap[check.Check]
+
+			line := ""
+			if check.Line > 0 {
+				line = fmt.Sprintf("%d", check.Line)
+			}
+			detail := f.description(check)
+			if checkInfo.HasValue && check.Value != nil && check.Value != "" {
+				detail += fmt.Sprintf(": %v", check.Value)
+			}
+
+			fmt.Fprintf(&b, "| %s | `%s` | %s | %s |\n", checkInfo.Severity, check.Check, line, escapeTableCell(detail))
+		}
+
+		if collapse {
+			b.WriteString("\n</details>\n")
+		}
+	}
+
+	if remaining := total - listed; remaining > 0 {
+		fmt.Fprintf(&b, "\n… and %d more finding%s.\n", remaining, pluralize(remaining))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// countSeverities returns the number of FAIL and WARN findings
+func (f *Formatter) countSeverities(results map[string]validator.ValidationResult) (int, int) {
+	nFails := 0
+	nWarnings := 0
+	for _, result := range results {
+		fails, warnings := f.splitBySeverity(result.Checks)
+		nFails += len(fails)
+		nWarnings += len(warnings)
+	}
+	return nFails, nWarnings
+}
+
+// splitBySeverity separates FAIL from WARN findings
+func (f *Formatter) splitBySeverity(checks []validator.CheckResult) ([]validator.CheckResult, []validator.CheckResult) {
+	var fails, warnings []validator.CheckResult
+	for _, check := range checks {
+		if f.checksMap[check.Check].Severity == validator.SeverityFail {
+			fails = append(fails, check)
+		} else {
+			warnings = append(warnings, check)
+		}
+	}
+	return fails, warnings
+}
+
+// severityCounts describes the number of findings per severity, like
+// "2 FAIL, 1 WARN"
+func severityCounts(nFails, nWarnings int) string {
+	var parts []string
+	if nFails > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s", nFails, validator.SeverityFail))
+	}
+	if nWarnings > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s", nWarnings, validator.SeverityWarn))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// escapeHTML escapes text used within HTML tags in Markdown
+func escapeHTML(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
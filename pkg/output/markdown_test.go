@@ -0,0 +1,77 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+func TestWriteMarkdown(t *testing.T) {
+	manyResults := make(map[string]validator.ValidationResult)
+	for i := 0; i < 6; i++ {
+		manyResults[fmt.Sprintf("docs/page-%d.md", i)] = validator.ValidationResult{
+			Checks: []validator.CheckResult{
+				{Check: validator.NoWeight},
+				{Check: validator.NoTitle, Line: 1},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		results     map[string]validator.ValidationResult
+		maxFindings int
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:     "no findings",
+			results:  map[string]validator.ValidationResult{},
+			contains: []string{"No problems found."},
+		},
+		{
+			name:     "few findings are not collapsed",
+			results:  escapingResults,
+			contains: []string{"| FAIL | 5 |", "| WARN | 1 |", "6 findings in 3 files.", "#### `src/content/docs/b.md`", "| FAIL | `LONG_DESCRIPTION` | 3 | The description should be less than 300 characters: 312 |", "100%\\| "},
+			notContains: []string{
+				"<details>",
+				"more finding",
+			},
+		},
+		{
+			name:     "many findings are collapsed",
+			results:  manyResults,
+			contains: []string{"<details>\n<summary><code>docs/page-0.md</code> (1 FAIL, 1 WARN)</summary>", "| FAIL | `NO_TITLE` | 1 | The page should have a title |\n| WARN | `NO_WEIGHT` |"},
+		},
+		{
+			name:        "findings are capped",
+			results:     manyResults,
+			maxFindings: 3,
+			contains:    []string{"docs/page-1.md", "… and 9 more findings."},
+			notContains: []string{"docs/page-2.md"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := New(WithMaxFindings(tt.maxFindings)).WriteMarkdown(&buf, tt.results); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			out := buf.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(out, want) {
+					t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+				}
+			}
+			for _, unwanted := range tt.notContains {
+				if strings.Contains(out, unwanted) {
+					t.Errorf("Expected output not to contain %q, got:\n%s", unwanted, out)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package output
+
+import (
+	"regexp"
+	"strings"
+)
+
+// OwnerRule maps an owner URL to the labels, assignees and project IDs of
+// the issues filed for pages with that owner
+type OwnerRule struct {
+	Owner     string
+	Labels    []string
+	Assignees []string
+	Projects  []string
+}
+
+// OwnerMapping resolves page owners to issue metadata. Owners are looked up
+// in Rules first. For owners without a rule, and pages without an owner, the
+// Fallback values apply, plus a label derived from the owner URL if
+// DeriveLabels is set.
+type OwnerMapping struct {
+	Rules        []OwnerRule
+	Fallback     OwnerRule
+	DeriveLabels bool
+}
+
+// OwnerTargets holds the resolved issue metadata for a page
+type OwnerTargets struct {
+	Labels    []string
+	Assignees []string
+	Projects  []string
+}
+
+// DefaultOwnerMapping derives labels from owner URLs, without explicit rules
+var DefaultOwnerMapping = OwnerMapping{DeriveLabels: true}
+
+// WithOwnerMapping sets how page owners are resolved for review issues
+func WithOwnerMapping(mapping OwnerMapping) Option {
+	return func(f *Formatter) {
+		f.ownerMapping = mapping
+	}
+}
+
+// Resolve returns the issue metadata for the owners of a page
+func (m OwnerMapping) Resolve(owners []string) OwnerTargets {
+	var targets OwnerTargets
+	useFallback := len(owners) == 0
+
+	for _, owner := range owners {
+		rule, found := m.rule(owner)
+		if !found {
+			useFallback = true
+			if m.DeriveLabels {
+				if label := teamLabel(owner); label != "" {
+					targets.Labels = appendUnique(targets.Labels, label)
+				}
+			}
+			continue
+		}
+		targets.add(rule)
+	}
+
+	if useFallback {
+		targets.add(m.Fallback)
+	}
+
+	return targets
+}
+
+// rule returns the rule for an owner. Owner URLs are compared case-insensitively
+// and without trailing slashes.
+func (m OwnerMapping) rule(owner string) (OwnerRule, bool) {
+	for _, rule := range m.Rules {
+		if normalizeOwner(rule.Owner) == normalizeOwner(owner) {
+			return rule, true
+		}
+	}
+	return OwnerRule{}, false
+}
+
+// add appends the values of a rule that are not yet present
+func (t *OwnerTargets) add(rule OwnerRule) {
+	for _, label := range rule.Labels {
+		t.Labels = appendUnique(t.Labels, label)
+	}
+	for _, assignee := range rule.Assignees {
+		t.Assignees = appendUnique(t.Assignees, assignee)
+	}
+	for _, project := range rule.Projects {
+		t.Projects = appendUnique(t.Projects, project)
+	}
+}
+
+// teamLabelPattern matches the team slug at the end of an owner URL
+var teamLabelPattern = regexp.MustCompile(`/.*\/([^\/]+)\/?$`)
+
+// teamLabel turns an owner URL like
+// "https://github.com/orgs/giantswarm/teams/team-honey-badger" into a label
+// like "team/honey-badger"
+func teamLabel(owner string) string {
+	matches := teamLabelPattern.FindStringSubmatch(owner)
+	if len(matches) < 2 {
+		return ""
+	}
+	return strings.Replace(matches[1], "-", "/", 1)
+}
+
+// normalizeOwner makes owner URLs comparable
+func normalizeOwner(owner string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(owner), "/"))
+}
+
+// appendUnique appends a value unless it is already present
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
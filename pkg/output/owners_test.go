@@ -0,0 +1,92 @@
+package output
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOwnerMapping_Resolve(t *testing.T) {
+	mapping := OwnerMapping{
+		Rules: []OwnerRule{
+			{
+				Owner:     "https://github.com/orgs/giantswarm/teams/team-honey-badger",
+				Labels:    []string{"team/honey-badger", "area/docs"},
+				Assignees: []string{"alice"},
+				Projects:  []string{"PVT_1"},
+			},
+			{
+				Owner:  "https://github.com/orgs/giantswarm/teams/team-phoenix",
+				Labels: []string{"team/phoenix", "area/docs"},
+			},
+		},
+		Fallback: OwnerRule{
+			Labels:   []string{"needs-triage"},
+			Projects: []string{"PVT_0"},
+		},
+		DeriveLabels: true,
+	}
+
+	tests := []struct {
+		name     string
+		mapping  OwnerMapping
+		owners   []string
+		expected OwnerTargets
+	}{
+		{
+			name:    "explicit rule with trailing slash and different case",
+			mapping: mapping,
+			owners:  []string{"https://github.com/orgs/giantswarm/teams/Team-Honey-Badger/"},
+			expected: OwnerTargets{
+				Labels:    []string{"team/honey-badger", "area/docs"},
+				Assignees: []string{"alice"},
+				Projects:  []string{"PVT_1"},
+			},
+		},
+		{
+			name:    "several owners are merged without duplicates",
+			mapping: mapping,
+			owners: []string{
+				"https://github.com/orgs/giantswarm/teams/team-honey-badger",
+				"https://github.com/orgs/giantswarm/teams/team-phoenix",
+			},
+			expected: OwnerTargets{
+				Labels:    []string{"team/honey-badger", "area/docs", "team/phoenix"},
+				Assignees: []string{"alice"},
+				Projects:  []string{"PVT_1"},
+			},
+		},
+		{
+			name:    "unmapped owner uses derived label and fallback",
+			mapping: mapping,
+			owners:  []string{"https://github.com/orgs/giantswarm/teams/team-big-mac"},
+			expected: OwnerTargets{
+				Labels:   []string{"team/big-mac", "needs-triage"},
+				Projects: []string{"PVT_0"},
+			},
+		},
+		{
+			name:    "page without owner uses fallback",
+			mapping: mapping,
+			owners:  nil,
+			expected: OwnerTargets{
+				Labels:   []string{"needs-triage"},
+				Projects: []string{"PVT_0"},
+			},
+		},
+		{
+			name:     "derived labels disabled",
+			mapping:  OwnerMapping{},
+			owners:   []string{"https://github.com/orgs/giantswarm/teams/team-phoenix"},
+			expected: OwnerTargets{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets := tt.mapping.Resolve(tt.owners)
+			if !reflect.DeepEqual(targets, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, targets)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// Default templates for review issues
+const (
+	DefaultReviewIssueTitle   = `Doc entry "{{ .Title }}" needs to be reviewed`
+	DefaultReviewIssueMessage = `{{ .Check.Description }} for [this document]({{ .URL }}).`
+)
+
+// ReviewIssues defines how review issues are rendered
+type ReviewIssues struct {
+	linkBase string
+	title    *template.Template
+	message  *template.Template
+}
+
+// ReviewIssueData is available in the review issue title and message
+// templates
+type ReviewIssueData struct {
+	// File is the path of the page, as validated
+	File string
+	// URL is the link to the page, the link base followed by the file path
+	URL string
+	// Title is the title of the page
+	Title string
+	// Page holds all frontmatter fields of the page
+	Page *validator.FrontMatter
+	// Check is the check that created the issue
+	Check validator.Check
+	// Value is the offending value, if the check reports one
+	Value interface{}
+	// Owners are the owner URLs of the page
+	Owners []string
+	// Labels, Assignees and Projects are resolved from the owners via the
+	// owner mapping
+	Labels    []string
+	Assignees []string
+	Projects  []string
+}
+
+// NewReviewIssues parses the review issue templates. Empty values fall back to
+// validator.DocsHost and the default templates.
+func NewReviewIssues(linkBase, titleTemplate, messageTemplate string) (*ReviewIssues, error) {
+	if linkBase == "" {
+		linkBase = validator.DocsHost
+	}
+	if titleTemplate == "" {
+		titleTemplate = DefaultReviewIssueTitle
+	}
+	if messageTemplate == "" {
+		messageTemplate = DefaultReviewIssueMessage
+	}
+
+	title, err := template.New("title").Option("missingkey=error").Parse(titleTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid review issue title template: %w", err)
+	}
+	message, err := template.New("message").Option("missingkey=error").Parse(messageTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid review issue message template: %w", err)
+	}
+
+	return &ReviewIssues{
+		linkBase: linkBase,
+		title:    title,
+		message:  message,
+	}, nil
+}
+
+// WithReviewIssues sets how review issues are rendered
+func WithReviewIssues(reviewIssues *ReviewIssues) Option {
+	return func(f *Formatter) {
+		f.reviewIssues = reviewIssues
+	}
+}
+
+// URL returns the link to a file
+func (r *ReviewIssues) URL(filePath string) string {
+	filePath = strings.TrimPrefix(filePath, "./")
+	if !strings.HasSuffix(r.linkBase, "/") {
+		return r.linkBase + "/" + filePath
+	}
+	return r.linkBase + filePath
+}
+
+// render executes the title and message templates
+func (r *ReviewIssues) render(data ReviewIssueData) (string, string, error) {
+	var title, message bytes.Buffer
+	if err := r.title.Execute(&title, data); err != nil {
+		return "", "", fmt.Errorf("failed to render review issue title for %s: %w", data.File, err)
+	}
+	if err := r.message.Execute(&message, data); err != nil {
+		return "", "", fmt.Errorf("failed to render review issue message for %s: %w", data.File, err)
+	}
+	return title.String(), message.String(), nil
+}
@@ -0,0 +1,95 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+func TestWriteReviewIssues_Templates(t *testing.T) {
+	results := map[string]validator.ValidationResult{
+		"./src/content/docs/page.md": {
+			Owner:       []string{"https://github.com/orgs/example/teams/team-docs"},
+			FrontMatter: &validator.FrontMatter{Title: "Page", Description: "About the page."},
+			Checks: []validator.CheckResult{
+				{
+					Check: validator.ReviewTooLongAgo,
+					Title: "Page",
+					Owner: []string{"https://github.com/orgs/example/teams/team-docs"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		linkBase        string
+		title           string
+		message         string
+		expectedTitle   string
+		expectedMessage string
+		expectErr       bool
+	}{
+		{
+			name:            "defaults",
+			expectedTitle:   `Doc entry "Page" needs to be reviewed`,
+			expectedMessage: "The last review date is too long ago for [this document](https://github.com/giantswarm/docs/blob/main/src/content/docs/page.md).",
+		},
+		{
+			name:            "custom link base and templates",
+			linkBase:        "https://github.com/example/handbook/blob/trunk",
+			title:           `Review "{{ .Page.Title }}" ({{ .Check.ID }})`,
+			message:         `{{ .Page.Description }} See {{ .URL }}, owned by {{ range .Labels }}{{ . }}{{ end }}.`,
+			expectedTitle:   `Review "Page" (REVIEW_TOO_LONG_AGO)`,
+			expectedMessage: "About the page. See https://github.com/example/handbook/blob/trunk/src/content/docs/page.md, owned by team/docs.",
+		},
+		{
+			name:      "unknown field",
+			title:     `{{ .Nope }}`,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviewIssues, err := NewReviewIssues(tt.linkBase, tt.title, tt.message)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var buf bytes.Buffer
+			err = New(WithReviewIssues(reviewIssues)).WriteReviewIssues(&buf, results)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Expected error, got %s", buf.String())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var issues []validator.JSONOutput
+			if err := json.Unmarshal(buf.Bytes(), &issues); err != nil {
+				t.Fatalf("Output does not parse: %v", err)
+			}
+			if len(issues) != 1 {
+				t.Fatalf("Expected 1 issue, got %d", len(issues))
+			}
+			if issues[0].Title != tt.expectedTitle {
+				t.Errorf("Expected title %q, got %q", tt.expectedTitle, issues[0].Title)
+			}
+			if issues[0].Message != tt.expectedMessage {
+				t.Errorf("Expected message %q, got %q", tt.expectedMessage, issues[0].Message)
+			}
+		})
+	}
+}
+
+func TestNewReviewIssues_InvalidTemplate(t *testing.T) {
+	if _, err := NewReviewIssues("", "{{ .Title ", ""); err == nil {
+		t.Error("Expected error for invalid title template")
+	}
+}
@@ -0,0 +1,124 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+const sarifSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+// sarifReport is the root object of a SARIF 2.1.0 log
+type sarifReport struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+// sarifRun holds the tool description and its results
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+// sarifDriver describes the validator and all of its checks as rules
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+// sarifResult represents a single finding
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// WriteSARIF writes the results as a SARIF 2.1.0 log, as used by GitHub code
+// scanning and many editors. All checks are listed as rules. FAIL findings
+// have level "error", WARN findings have level "warning".
+func (f *Formatter) WriteSARIF(w io.Writer, results map[string]validator.ValidationResult) error {
+	driver := sarifDriver{
+		Name:           "frontmatter-validator",
+		InformationURI: "https://github.com/giantswarm/frontmatter-validator",
+		Rules:          []sarifRule{},
+	}
+	for _, check := range validator.GetChecks() {
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:                   check.ID,
+			ShortDescription:     sarifMessage{Text: check.Description},
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevel(check.Severity)},
+		})
+	}
+
+	run := sarifRun{Tool: sarifTool{Driver: driver}, Results: []sarifResult{}}
+	for _, filePath := range sortedKeys(results) {
+		for _, check := range results[filePath].Checks {
+			run.Results = append(run.Results, sarifResult{
+				RuleID:  check.Check,
+				Level:   sarifLevel(f.checksMap[check.Check].Severity),
+				Message: sarifMessage{Text: f.findingText(check)},
+				Locations: []sarifLocation{{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: filePath},
+						Region:           sarifRegion{StartLine: maxInt(check.Line, 1)},
+					},
+				}},
+			})
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifReport{
+		Schema:  sarifSchema,
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	})
+}
+
+// sarifLevel maps a check severity to a SARIF level
+func sarifLevel(severity string) string {
+	if severity == validator.SeverityFail {
+		return "error"
+	}
+	return "warning"
+}
@@ -0,0 +1,35 @@
+package project
+
+// These values are set at build time via -ldflags
+var (
+	buildTimestamp = ""
+	gitSHA         = "n/a"
+	name           = "frontmatter-validator"
+	source         = "https://github.com/giantswarm/frontmatter-validator"
+	version        = "dev"
+)
+
+// BuildTimestamp returns the time the binary was built
+func BuildTimestamp() string {
+	return buildTimestamp
+}
+
+// GitSHA returns the commit the binary was built from
+func GitSHA() string {
+	return gitSHA
+}
+
+// Name returns the name of the application
+func Name() string {
+	return name
+}
+
+// Source returns the URL of the source repository
+func Source() string {
+	return source
+}
+
+// Version returns the version of the application
+func Version() string {
+	return version
+}
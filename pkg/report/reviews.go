@@ -0,0 +1,234 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+// Review buckets, in order of urgency
+const (
+	BucketMissing = "missing"
+	BucketOverdue = "overdue"
+	BucketDue30   = "due-30"
+	BucketDue60   = "due-60"
+	BucketDue90   = "due-90"
+	BucketLater   = "later"
+)
+
+// Unowned is the team name used for pages without an owner
+const Unowned = "unowned"
+
+// Buckets lists all review buckets in order of urgency
+var Buckets = []string{BucketMissing, BucketOverdue, BucketDue30, BucketDue60, BucketDue90, BucketLater}
+
+// ReviewEntry describes the review status of a single page
+type ReviewEntry struct {
+	File             string   `json:"file"`
+	Title            string   `json:"title"`
+	Owners           []string `json:"owners,omitempty"`
+	LastReviewDate   string   `json:"last_review_date,omitempty"`
+	ExpirationInDays int      `json:"expiration_in_days"`
+	DueDate          string   `json:"due_date,omitempty"`
+	DaysUntilDue     int      `json:"days_until_due"`
+	Bucket           string   `json:"bucket"`
+}
+
+// TeamReviews holds the review entries of all pages owned by one team
+type TeamReviews struct {
+	Team    string         `json:"team"`
+	Buckets map[string]int `json:"buckets"`
+	Pages   []ReviewEntry  `json:"pages"`
+}
+
+// ReviewReport is the review-due forecast for a content tree
+type ReviewReport struct {
+	Date  string        `json:"date"`
+	Teams []TeamReviews `json:"teams"`
+}
+
+// NewReviewEntry computes the review status of a page as of the given date. The
+// comparison uses calendar dates, like the REVIEW_TOO_LONG_AGO check.
+func NewReviewEntry(filePath string, fm *validator.FrontMatter, today time.Time) ReviewEntry {
+	entry := ReviewEntry{
+		File:             filePath,
+		Title:            fm.Title,
+		Owners:           fm.Owner,
+		ExpirationInDays: validator.DefaultExpirationInDays,
+		Bucket:           BucketMissing,
+	}
+	if fm.ExpirationInDays != nil {
+		entry.ExpirationInDays = *fm.ExpirationInDays
+	}
+
+	if fm.LastReviewDate == nil {
+		return entry
+	}
+
+	lastReview := validator.CalendarDate(fm.LastReviewDate.Time)
+	due := lastReview.AddDate(0, 0, entry.ExpirationInDays)
+
+	entry.LastReviewDate = lastReview.Format("2006-01-02")
+	entry.DueDate = due.Format("2006-01-02")
+	entry.DaysUntilDue = int(due.Sub(validator.CalendarDate(today)).Hours() / 24)
+	entry.Bucket = bucketFor(entry.DaysUntilDue)
+
+	return entry
+}
+
+// NewReviewReport groups review entries by owner team. Pages with several
+// owners are listed for each of them.
+func NewReviewReport(entries []ReviewEntry, today time.Time) ReviewReport {
+	byTeam := make(map[string]*TeamReviews)
+
+	for _, entry := range entries {
+		teams := []string{Unowned}
+		if len(entry.Owners) > 0 {
+			teams = nil
+			for _, owner := range entry.Owners {
+				teams = append(teams, TeamName(owner))
+			}
+		}
+
+		for _, team := range teams {
+			if byTeam[team] == nil {
+				byTeam[team] = &TeamReviews{Team: team, Buckets: make(map[string]int)}
+			}
+			byTeam[team].Pages = append(byTeam[team].Pages, entry)
+			byTeam[team].Buckets[entry.Bucket]++
+		}
+	}
+
+	report := ReviewReport{Date: validator.CalendarDate(today).Format("2006-01-02")}
+	for _, team := range byTeam {
+		sort.SliceStable(team.Pages, func(i, j int) bool {
+			return lessUrgent(team.Pages[j], team.Pages[i])
+		})
+		report.Teams = append(report.Teams, *team)
+	}
+	sort.Slice(report.Teams, func(i, j int) bool {
+		return report.Teams[i].Team < report.Teams[j].Team
+	})
+
+	return report
+}
+
+// TeamName returns the team slug of an owner URL such as
+// "https://github.com/orgs/giantswarm/teams/team-honeybadger"
+func TeamName(owner string) string {
+	owner = strings.TrimSuffix(owner, "/")
+	if i := strings.LastIndex(owner, "/"); i >= 0 {
+		return owner[i+1:]
+	}
+	return owner
+}
+
+// WriteTable writes the report as a human-readable table, one block per team
+func (r ReviewReport) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	for i, team := range r.Teams {
+		if i > 0 {
+			fmt.Fprintln(tw)
+		}
+
+		var counts []string
+		for _, bucket := range Buckets {
+			if n := team.Buckets[bucket]; n > 0 {
+				counts = append(counts, fmt.Sprintf("%s: %d", bucket, n))
+			}
+		}
+		fmt.Fprintf(tw, "%s (%s)\n", team.Team, strings.Join(counts, ", "))
+		fmt.Fprintln(tw, "BUCKET\tDUE DATE\tLAST REVIEW\tEXPIRATION\tFILE")
+		for _, page := range team.Pages {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\n",
+				page.Bucket, orDash(page.DueDate), orDash(page.LastReviewDate), page.ExpirationInDays, page.File)
+		}
+	}
+
+	return tw.Flush()
+}
+
+// WriteCSV writes the report as CSV, one row per team and page
+func (r ReviewReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"team", "bucket", "due_date", "days_until_due", "last_review_date", "expiration_in_days", "file", "title"}); err != nil {
+		return err
+	}
+
+	for _, team := range r.Teams {
+		for _, page := range team.Pages {
+			daysUntilDue := ""
+			if page.DueDate != "" {
+				daysUntilDue = strconv.Itoa(page.DaysUntilDue)
+			}
+			record := []string{
+				team.Team,
+				page.Bucket,
+				page.DueDate,
+				daysUntilDue,
+				page.LastReviewDate,
+				strconv.Itoa(page.ExpirationInDays),
+				page.File,
+				page.Title,
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the report as indented JSON
+func (r ReviewReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// bucketFor returns the bucket for a page due in the given number of days
+func bucketFor(daysUntilDue int) string {
+	switch {
+	case daysUntilDue < 0:
+		return BucketOverdue
+	case daysUntilDue <= 30:
+		return BucketDue30
+	case daysUntilDue <= 60:
+		return BucketDue60
+	case daysUntilDue <= 90:
+		return BucketDue90
+	default:
+		return BucketLater
+	}
+}
+
+// lessUrgent reports whether entry a can wait longer than entry b. Pages
+// without a review date are the most urgent.
+func lessUrgent(a, b ReviewEntry) bool {
+	if a.DueDate == "" || b.DueDate == "" {
+		return a.DueDate != "" && b.DueDate == ""
+	}
+	if a.DueDate != b.DueDate {
+		return a.DueDate > b.DueDate
+	}
+	return a.File > b.File
+}
+
+// orDash returns "-" for empty strings
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
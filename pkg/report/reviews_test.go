@@ -0,0 +1,201 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/frontmatter-validator/pkg/validator"
+)
+
+func TestNewReviewEntry(t *testing.T) {
+	today := time.Date(2026, 3, 15, 18, 30, 0, 0, time.UTC)
+	days := func(n int) *int { return &n }
+	date := func(s string) *validator.FlexibleDate {
+		parsed, _ := time.Parse("2006-01-02", s)
+		return &validator.FlexibleDate{Time: parsed}
+	}
+
+	tests := []struct {
+		name             string
+		fm               validator.FrontMatter
+		wantBucket       string
+		wantDueDate      string
+		wantDaysUntilDue int
+		wantExpiration   int
+	}{
+		{
+			name:           "no review date",
+			fm:             validator.FrontMatter{},
+			wantBucket:     BucketMissing,
+			wantExpiration: 365,
+		},
+		{
+			name:             "overdue by one day",
+			fm:               validator.FrontMatter{LastReviewDate: date("2025-03-14")},
+			wantBucket:       BucketOverdue,
+			wantDueDate:      "2026-03-14",
+			wantDaysUntilDue: -1,
+			wantExpiration:   365,
+		},
+		{
+			name:             "due today",
+			fm:               validator.FrontMatter{LastReviewDate: date("2025-03-15")},
+			wantBucket:       BucketDue30,
+			wantDueDate:      "2026-03-15",
+			wantDaysUntilDue: 0,
+			wantExpiration:   365,
+		},
+		{
+			name:             "custom expiration due in 45 days",
+			fm:               validator.FrontMatter{LastReviewDate: date("2026-03-01"), ExpirationInDays: days(59)},
+			wantBucket:       BucketDue60,
+			wantDueDate:      "2026-04-29",
+			wantDaysUntilDue: 45,
+			wantExpiration:   59,
+		},
+		{
+			name:             "due in 90 days",
+			fm:               validator.FrontMatter{LastReviewDate: date("2026-03-15"), ExpirationInDays: days(90)},
+			wantBucket:       BucketDue90,
+			wantDueDate:      "2026-06-13",
+			wantDaysUntilDue: 90,
+			wantExpiration:   90,
+		},
+		{
+			name:             "due later",
+			fm:               validator.FrontMatter{LastReviewDate: date("2026-03-01")},
+			wantBucket:       BucketLater,
+			wantDueDate:      "2027-03-01",
+			wantDaysUntilDue: 351,
+			wantExpiration:   365,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := NewReviewEntry("docs/page.md", &tt.fm, today)
+			if entry.Bucket != tt.wantBucket {
+				t.Errorf("Expected bucket %s, got %s", tt.wantBucket, entry.Bucket)
+			}
+			if entry.DueDate != tt.wantDueDate {
+				t.Errorf("Expected due date %q, got %q", tt.wantDueDate, entry.DueDate)
+			}
+			if entry.DaysUntilDue != tt.wantDaysUntilDue {
+				t.Errorf("Expected %d days until due, got %d", tt.wantDaysUntilDue, entry.DaysUntilDue)
+			}
+			if entry.ExpirationInDays != tt.wantExpiration {
+				t.Errorf("Expected expiration %d, got %d", tt.wantExpiration, entry.ExpirationInDays)
+			}
+		})
+	}
+}
+
+func TestNewReviewReport(t *testing.T) {
+	today := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	entries := []ReviewEntry{
+		{File: "b.md", Owners: []string{"https://github.com/orgs/giantswarm/teams/team-honey-badger"}, DueDate: "2026-05-01", Bucket: BucketDue60},
+		{File: "a.md", Owners: []string{"https://github.com/orgs/giantswarm/teams/team-honey-badger/"}, DueDate: "2026-01-01", Bucket: BucketOverdue},
+		{File: "c.md", Owners: []string{"https://github.com/orgs/giantswarm/teams/team-honey-badger", "https://github.com/orgs/giantswarm/teams/team-phoenix"}, Bucket: BucketMissing},
+		{File: "d.md", DueDate: "2027-01-01", Bucket: BucketLater},
+	}
+
+	report := NewReviewReport(entries, today)
+
+	if report.Date != "2026-03-15" {
+		t.Errorf("Expected date 2026-03-15, got %s", report.Date)
+	}
+
+	var teams []string
+	for _, team := range report.Teams {
+		teams = append(teams, team.Team)
+	}
+	if strings.Join(teams, ",") != "team-honey-badger,team-phoenix,unowned" {
+		t.Fatalf("Unexpected teams: %v", teams)
+	}
+
+	var files []string
+	for _, page := range report.Teams[0].Pages {
+		files = append(files, page.File)
+	}
+	if strings.Join(files, ",") != "c.md,a.md,b.md" {
+		t.Errorf("Expected pages sorted by urgency, got %v", files)
+	}
+
+	if report.Teams[0].Buckets[BucketOverdue] != 1 || report.Teams[0].Buckets[BucketMissing] != 1 {
+		t.Errorf("Unexpected bucket counts: %v", report.Teams[0].Buckets)
+	}
+}
+
+func TestReviewReport_Write(t *testing.T) {
+	report := ReviewReport{
+		Date: "2026-03-15",
+		Teams: []TeamReviews{
+			{
+				Team:    "team-phoenix",
+				Buckets: map[string]int{BucketOverdue: 1, BucketMissing: 1},
+				Pages: []ReviewEntry{
+					{File: "docs/a.md", Title: "Page A", Bucket: BucketMissing, ExpirationInDays: 365},
+					{File: "docs/b.md", Title: "Page, B", Bucket: BucketOverdue, LastReviewDate: "2025-01-01", DueDate: "2026-01-01", DaysUntilDue: -73, ExpirationInDays: 365},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		write    func(*bytes.Buffer) error
+		contains []string
+	}{
+		{
+			name:  "table",
+			write: func(b *bytes.Buffer) error { return report.WriteTable(b) },
+			contains: []string{
+				"team-phoenix (missing: 1, overdue: 1)",
+				"missing  -",
+				"overdue  2026-01-01  2025-01-01   365         docs/b.md",
+			},
+		},
+		{
+			name:  "csv",
+			write: func(b *bytes.Buffer) error { return report.WriteCSV(b) },
+			contains: []string{
+				"team,bucket,due_date,days_until_due,last_review_date,expiration_in_days,file,title\n",
+				"team-phoenix,missing,,,,365,docs/a.md,Page A\n",
+				"team-phoenix,overdue,2026-01-01,-73,2025-01-01,365,docs/b.md,\"Page, B\"\n",
+			},
+		},
+		{
+			name:  "json",
+			write: func(b *bytes.Buffer) error { return report.WriteJSON(b) },
+			contains: []string{
+				`"team": "team-phoenix"`,
+				`"due_date": "2026-01-01"`,
+				`"overdue": 1`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.write(&buf); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("Expected output to contain %q, got:\n%s", want, buf.String())
+				}
+			}
+		})
+	}
+
+	var decoded ReviewReport
+	var buf bytes.Buffer
+	_ = report.WriteJSON(&buf)
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Errorf("JSON output does not parse: %v", err)
+	}
+}
@@ -5,6 +5,58 @@ const (
 	DocsHost = "https://github.com/giantswarm/docs/blob/main/"
 )
 
+// DefaultEnabledChecks returns the IDs of the checks enabled by the default
+// profile, which applies when no configuration is given, both to New and to
+// the command line tool. NO_DIATAXIS_CONTENT_TYPE and the link checks are
+// opt-in.
+func DefaultEnabledChecks() []string {
+	return []string{
+		NoFrontMatter,
+		NoTrailingNewline,
+		UnknownAttribute,
+		NoTitle,
+		LongTitle,
+		ShortTitle,
+		NoDescription,
+		LongDescription,
+		ShortDescription,
+		NoFullStopDescription,
+		InvalidDescription,
+		NoLinkTitle,
+		LongLinkTitle,
+		NoWeight,
+		NoOwner,
+		InvalidOwner,
+		NoLastReviewDate,
+		ReviewTooLongAgo,
+		InvalidLastReviewDate,
+		NonISODate,
+		InvalidDate,
+		InvalidExpirationInDays,
+		FutureDate,
+		InvalidWeight,
+		NoUserQuestions,
+		LongUserQuestion,
+		NoQuestionMark,
+		// INVALID_DIATAXIS_CONTENT_TYPE only fires on a bad value, so it is safe
+		// to enable everywhere
+		InvalidDiataxisContentType,
+		// Runbook checks only fire on pages with the runbook layout or fields
+		RunbookLayoutNotSet,
+		InvalidRunbookVariables,
+		RunbookVariableWithoutName,
+		InvalidRunbookVariableName,
+		InvalidRunbookVariable,
+		InvalidRunbookDashboards,
+		InvalidRunbookDashboard,
+		InvalidRunbookDashboardLink,
+		InvalidRunbookKnownIssues,
+		InvalidRunbookKnownIssue,
+		InvalidRunbookKnownIssueURL,
+		RunbookAppearsInMenu,
+	}
+}
+
 // GetChecks returns all validation checks in logical order
 func GetChecks() []Check {
 	return []Check{
@@ -13,204 +65,462 @@ func GetChecks() []Check {
 			ID:          NoFrontMatter,
 			Description: "No front matter found in the beginning of the page",
 			Severity:    SeverityFail,
+			Group:       GroupPrerequisites,
+			Long:        "The page must start with a YAML front matter block, delimited by lines containing only \"---\". All other checks depend on the front matter, so they are skipped for pages without one.",
+			Rationale:   "Hugo reads titles, menus and metadata from the front matter. Without it, the page cannot be listed, owned or reviewed.",
+			Good:        "---\ntitle: Install the CLI\n---\n\nContent.",
+			Bad:         "# Install the CLI\n\nContent.",
 		},
 		{
 			ID:          NoTrailingNewline,
 			Description: "There must be a newline character at the end of the page to ensure proper parsing",
 			Severity:    SeverityFail,
+			Group:       GroupPrerequisites,
+			Long:        "The file must end with a newline character.",
+			Rationale:   "Tools that concatenate or patch files, and some Markdown parsers, misbehave when the last line is not terminated.",
+			Good:        "Last line of content.\\n",
+			Bad:         "Last line of content. (no newline at the end of the file)",
 		},
 		{
 			ID:          UnknownAttribute,
 			Description: "There is an unknown front matter attribute in this page",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupPrerequisites,
+			Long:        "Every top-level front matter key must be one of the attributes known to the docs site. The value names the unknown key.",
+			Rationale:   "Unknown keys are usually typos of known ones, like \"linktitle\" instead of \"linkTitle\", and are silently ignored by Hugo.",
+			Good:        "linkTitle: Install",
+			Bad:         "linktitle: Install",
 		},
 		// Standard attributes
 		{
 			ID:          NoTitle,
 			Description: "The page should have a title",
 			Severity:    SeverityFail,
+			Group:       GroupTitle,
+			Long:        "The front matter must set a non-empty title.",
+			Rationale:   "The title is shown as the page heading, in the browser tab and in search results.",
+			Good:        "title: Install the CLI",
+			Bad:         "title: \"\"",
 		},
 		{
 			ID:          LongTitle,
 			Description: "The title should be less than 100 characters",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupTitle,
+			Long:        "The title must not be longer than 100 characters.",
+			Rationale:   "Long titles are cut off in search results and browser tabs, and are hard to scan.",
+			Good:        "title: Install the CLI on macOS",
+			Bad:         "title: This page explains in great detail how you can install the command line interface on all supported operating systems",
 		},
 		{
 			ID:          ShortTitle,
 			Description: "The title should be longer than 5 characters",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupTitle,
+			Long:        "The title must be at least 5 characters long.",
+			Rationale:   "Very short titles rarely describe the page well enough to find it.",
+			Good:        "title: Install the CLI",
+			Bad:         "title: CLI",
 		},
 		{
 			ID:          NoDescription,
 			Description: "Each page should have a description",
 			Severity:    SeverityFail,
+			Group:       GroupDescription,
+			Long:        "The front matter must set a non-empty description.",
+			Rationale:   "The description is used in list pages, link previews and as the meta description for search engines.",
+			Good:        "description: Learn how to install the command line interface on macOS, Linux and Windows.",
+			Bad:         "description: \"\"",
 		},
 		{
 			ID:          LongDescription,
 			Description: "The description should be less than 300 characters",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupDescription,
+			Long:        "The description must not be longer than 300 characters.",
+			Rationale:   "Search engines truncate long meta descriptions, and list pages become hard to read.",
+			Good:        "description: Learn how to install the command line interface on macOS, Linux and Windows.",
+			Bad:         "description: A description that goes on and on for more than 300 characters, repeating what the page itself explains in detail.",
 		},
 		{
 			ID:          NoFullStopDescription,
 			Description: "The description should end with a full stop",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupDescription,
+			Long:        "The description must end with a full stop.",
+			Rationale:   "Descriptions are full sentences, and consistent punctuation makes list pages look tidy.",
+			Good:        "description: Learn how to install the command line interface on macOS, Linux and Windows.",
+			Bad:         "description: Learn how to install the command line interface on macOS, Linux and Windows",
 		},
 		{
 			ID:          ShortDescription,
 			Description: "The description should be longer than 50 characters",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupDescription,
+			Long:        "The description must be at least 50 characters long.",
+			Rationale:   "A very short description rarely tells readers whether the page answers their question.",
+			Good:        "description: Learn how to install the command line interface on macOS, Linux and Windows.",
+			Bad:         "description: Installation.",
 		},
 		{
 			ID:          InvalidDescription,
 			Description: "Description must be a simple string without any markup or line breaks",
 			Severity:    SeverityFail,
+			Group:       GroupDescription,
+			Long:        "The description must be a single line of plain text, without line breaks or markup.",
+			Rationale:   "The description is rendered as plain text in meta tags and list pages, where markup and line breaks show up verbatim.",
+			Good:        "description: Learn how to install the command line interface on macOS, Linux and Windows.",
+			Bad:         "description: |\n  Learn how to install the **command line interface**\n  on macOS, Linux and Windows.",
 		},
 		{
 			ID:          NoLinkTitle,
 			Description: "The page should have a linkTitle, which appears in menus and list pages. If not given, title will be used and should be no longer than 40 characters.",
 			Severity:    SeverityWarn,
+			Group:       GroupNavigation,
+			Long:        "Pages that appear in a menu should set a linkTitle. The check only applies to pages with a menu entry.",
+			Rationale:   "Menus have little space, and the linkTitle allows a shorter label than the title.",
+			Good:        "title: Install the command line interface\nlinkTitle: Install\nmenu:\n  principal:\n    parent: getting-started",
+			Bad:         "title: Install the command line interface\nmenu:\n  principal:\n    parent: getting-started",
 		},
 		{
 			ID:          LongLinkTitle,
 			Description: "The linkTitle (used in menu and list pages; title is used if linkTitle is not given) should be less than 40 characters",
 			Severity:    SeverityFail,
+			Group:       GroupNavigation,
+			Long:        "The linkTitle must not be longer than 40 characters. Without a linkTitle, the title is checked instead.",
+			Rationale:   "The linkTitle is shown in menus and list pages, where long labels wrap or get cut off.",
+			Good:        "linkTitle: Install the CLI",
+			Bad:         "linkTitle: Install the command line interface on macOS and Linux",
 		},
 		{
 			ID:          NoWeight,
 			Description: "The page should have a weight attribute, to control the sort order",
 			Severity:    SeverityWarn,
+			Group:       GroupNavigation,
+			Long:        "Pages that appear in a menu should set a weight. The check only applies to pages with a menu entry.",
+			Rationale:   "Without a weight, menu entries are sorted by title, which rarely matches the intended reading order.",
+			Good:        "menu:\n  principal:\n    parent: getting-started\nweight: 20",
+			Bad:         "menu:\n  principal:\n    parent: getting-started",
 		},
 		// Custom attributes
 		{
 			ID:          NoOwner,
 			Description: "The page should have an owner assigned",
 			Severity:    SeverityFail,
+			Group:       GroupOwner,
+			Long:        "The front matter must list at least one owner.",
+			Rationale:   "Every page needs a team that keeps it up to date and receives review reminders.",
+			Good:        "owner:\n  - https://github.com/orgs/giantswarm/teams/team-phoenix",
+			Bad:         "title: Install the CLI",
 		},
 		{
 			ID:          InvalidOwner,
 			Description: "The owner field values must start with a Github teams URL",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupOwner,
+			Long:        "Each owner must be a GitHub team URL starting with https://github.com/orgs/giantswarm/teams/. The value names the invalid owner.",
+			Rationale:   "Owners are mapped to teams for review issues, which only works with team URLs.",
+			Good:        "owner:\n  - https://github.com/orgs/giantswarm/teams/team-phoenix",
+			Bad:         "owner:\n  - team-phoenix",
 		},
 		{
 			ID:          NoLastReviewDate,
 			Description: "The page should have a last_review_date",
 			Severity:    SeverityWarn,
+			Group:       GroupReview,
+			Long:        "The front matter should set a last_review_date.",
+			Rationale:   "Without a review date, nobody can tell whether the content is still accurate, and review reminders cannot be created.",
+			Good:        "last_review_date: 2025-01-10",
+			Bad:         "title: Install the CLI",
 		},
 		{
 			ID:          ReviewTooLongAgo,
 			Description: "The last review date is too long ago",
 			Severity:    SeverityWarn,
 			HasValue:    true,
+			Group:       GroupReview,
+			Long:        "The last_review_date must not be older than expiration_in_days, which defaults to 365 days. The value is the last review date.",
+			Rationale:   "Documentation goes stale as the product changes. Regular reviews keep it accurate.",
+			Good:        "last_review_date: 2025-01-10\nexpiration_in_days: 180",
+			Bad:         "last_review_date: 2019-03-01",
 		},
 		{
 			ID:          InvalidLastReviewDate,
 			Description: "The last_review_date should be in format YYYY-MM-DD and not in the future",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupReview,
+			Long:        "The last_review_date must be a valid date and not in the future. With strict_dates enabled, ambiguous MM/DD/YYYY and DD/MM/YYYY dates are rejected as well.",
+			Rationale:   "A review date in the future would suppress review reminders for a page that was never reviewed on that date.",
+			Good:        "last_review_date: 2025-01-10",
+			Bad:         "last_review_date: 2099-01-01",
+		},
+		{
+			ID:          NonISODate,
+			Description: "Dates should be written as YYYY-MM-DD, since formats like MM/DD/YYYY and DD/MM/YYYY are ambiguous",
+			Severity:    SeverityWarn,
+			HasValue:    true,
+			Group:       GroupDates,
+			Long:        "The last_review_date must be written as YYYY-MM-DD, and date as YYYY-MM-DD or an ISO 8601 timestamp. The value is the date as written.",
+			Rationale:   "A date like 03/04/2025 means March 4 in some places and April 3 in others.",
+			Good:        "last_review_date: 2025-04-03",
+			Bad:         "last_review_date: 03/04/2025",
+		},
+		{
+			ID:          InvalidDate,
+			Description: "The date should be in format YYYY-MM-DD or an ISO 8601 timestamp",
+			Severity:    SeverityFail,
+			HasValue:    true,
+			Group:       GroupDates,
+			Long:        "With strict_dates enabled, the date must not use the ambiguous MM/DD/YYYY or DD/MM/YYYY formats. Such a date is not checked for being in the future, since it can't be read reliably. Without strict_dates, the check doesn't fire.",
+			Rationale:   "A date like 03/04/2025 is read as March 4, even if the author meant April 3, which changes the order of pages and can hide them as future-dated.",
+			Good:        "date: 2025-04-03",
+			Bad:         "date: 03/04/2025",
+		},
+		{
+			ID:          InvalidExpirationInDays,
+			Description: "The expiration_in_days value is outside the allowed range",
+			Severity:    SeverityFail,
+			HasValue:    true,
+			Group:       GroupValues,
+			Long:        "The expiration_in_days value must lie within the allowed range, by default 1 to 1095 days. The range can be changed with thresholds.expiration_in_days.",
+			Rationale:   "Values outside the range are almost always mistakes, like a review interval of zero days or of several decades.",
+			Good:        "expiration_in_days: 180",
+			Bad:         "expiration_in_days: 10000",
+		},
+		{
+			ID:          FutureDate,
+			Description: "The date is in the future, which hides the page in Hugo",
+			Severity:    SeverityFail,
+			HasValue:    true,
+			Group:       GroupDates,
+			Long:        "The date must not be in the future.",
+			Rationale:   "Hugo does not publish pages with a date in the future, so the page silently disappears from the site.",
+			Good:        "date: 2025-01-10",
+			Bad:         "date: 2099-01-01",
+		},
+		{
+			ID:          InvalidWeight,
+			Description: "The weight value is outside the allowed range",
+			Severity:    SeverityFail,
+			HasValue:    true,
+			Group:       GroupValues,
+			Long:        "The weight value must lie within the allowed range, by default -100000 to 100000. The range can be changed with thresholds.weight.",
+			Rationale:   "Extreme weights are usually typos and make the sort order hard to maintain.",
+			Good:        "weight: 20",
+			Bad:         "weight: 99999999",
 		},
 		{
 			ID:          NoUserQuestions,
 			Description: "The page should have user_questions assigned",
 			Severity:    SeverityFail,
+			Group:       GroupUserQuestions,
+			Long:        "The front matter must list the user_questions the page answers. Section index pages (_index.md) are exempt.",
+			Rationale:   "User questions help readers and search to find the page that answers their question.",
+			Good:        "user_questions:\n  - How do I install the CLI on macOS?",
+			Bad:         "title: Install the CLI",
 		},
 		{
 			ID:          LongUserQuestion,
 			Description: "Each user question should be no longer than 100 characters",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupUserQuestions,
+			Long:        "Each user question must not be longer than 100 characters. The value is the question.",
+			Rationale:   "Questions are shown as a list on the page and should be quick to scan.",
+			Good:        "user_questions:\n  - How do I install the CLI on macOS?",
+			Bad:         "user_questions:\n  - How do I install the command line interface on a laptop running macOS when I do not have administrator permissions and no Homebrew?",
 		},
 		{
 			ID:          NoQuestionMark,
 			Description: "Questions should end with a question mark",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupUserQuestions,
+			Long:        "Each user question must end with a question mark. The value is the question.",
+			Rationale:   "User questions are phrased the way readers ask them, and statements make the list inconsistent.",
+			Good:        "user_questions:\n  - How do I install the CLI on macOS?",
+			Bad:         "user_questions:\n  - Installing the CLI on macOS",
 		},
 		// Diátaxis checks
 		{
 			ID:          NoDiataxisContentType,
 			Description: "The page should declare a diataxis_content_type (tutorial, how-to-guide, reference, explanation, or none)",
 			Severity:    SeverityFail,
+			Group:       GroupDiataxis,
+			Long:        "The front matter must set diataxis_content_type. Section index pages (_index.md) are exempt.",
+			Rationale:   "The Diátaxis content type tells authors and readers which kind of documentation a page is, and keeps tutorials, guides, references and explanations apart.",
+			Good:        "diataxis_content_type: how-to-guide",
+			Bad:         "title: Install the CLI",
 		},
 		{
 			ID:          InvalidDiataxisContentType,
 			Description: "diataxis_content_type must be one of: tutorial, how-to-guide, reference, explanation, none",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupDiataxis,
+			Long:        "The diataxis_content_type must be one of tutorial, how-to-guide, reference, explanation or none. The value is the given type.",
+			Rationale:   "Only the known types can be used to filter and organize content.",
+			Good:        "diataxis_content_type: how-to-guide",
+			Bad:         "diataxis_content_type: guide",
 		},
 		// Runbook checks
 		{
 			ID:          RunbookLayoutNotSet,
 			Description: "Runbook pages must have layout: runbook",
 			Severity:    SeverityFail,
+			Group:       GroupRunbook,
+			Long:        "Pages with a runbook block must set layout: runbook.",
+			Rationale:   "Runbook variables, dashboards and known issues are only rendered by the runbook layout.",
+			Good:        "layout: runbook\ntoc_hide: true\nrunbook:\n  variables:\n    - name: CLUSTER",
+			Bad:         "runbook:\n  variables:\n    - name: CLUSTER",
 		},
 		{
 			ID:          InvalidRunbookVariables,
 			Description: "Runbook variables must be a valid array if present",
 			Severity:    SeverityFail,
+			Group:       GroupRunbook,
+			Long:        "The runbook variables must be a list.",
+			Rationale:   "The runbook layout iterates over the variables and cannot render other structures.",
+			Good:        "runbook:\n  variables:\n    - name: CLUSTER",
+			Bad:         "runbook:\n  variables: CLUSTER",
 		},
 		{
 			ID:          RunbookVariableWithoutName,
 			Description: "Each runbook variable must have a name specified",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupRunbook,
+			Long:        "Each runbook variable must have a name. The value gives the index of the variable.",
+			Rationale:   "Variables are referenced by name in dashboard links and commands.",
+			Good:        "runbook:\n  variables:\n    - name: CLUSTER\n      description: Name of the workload cluster",
+			Bad:         "runbook:\n  variables:\n    - description: Name of the workload cluster",
 		},
 		{
 			ID:          InvalidRunbookVariableName,
 			Description: "Variable names must use only uppercase letters and underscores, and be unique",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupRunbook,
+			Long:        "Runbook variable names must consist of uppercase letters and underscores only, and be unique within the page. The value names the variable.",
+			Rationale:   "Variables are substituted as $NAME in links and commands, which only works for unambiguous names of this form.",
+			Good:        "runbook:\n  variables:\n    - name: CLUSTER_NAME",
+			Bad:         "runbook:\n  variables:\n    - name: cluster-name",
 		},
 		{
 			ID:          InvalidRunbookVariable,
 			Description: "Each variable must be a valid object with name field and optional description and default fields",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupRunbook,
+			Long:        "Each runbook variable must be an object with a name and optional description and default fields.",
+			Rationale:   "The runbook layout expects this structure to render the variable form.",
+			Good:        "runbook:\n  variables:\n    - name: CLUSTER\n      default: golem",
+			Bad:         "runbook:\n  variables:\n    - CLUSTER",
 		},
 		{
 			ID:          InvalidRunbookDashboards,
 			Description: "Runbook dashboards must be a valid array if present",
 			Severity:    SeverityFail,
+			Group:       GroupRunbook,
+			Long:        "The runbook dashboards must be a list.",
+			Rationale:   "The runbook layout iterates over the dashboards and cannot render other structures.",
+			Good:        "runbook:\n  dashboards:\n    - name: Cluster overview\n      link: https://grafana.example.com/d/cluster?var-cluster=$CLUSTER",
+			Bad:         "runbook:\n  dashboards: https://grafana.example.com/d/cluster",
 		},
 		{
 			ID:          InvalidRunbookDashboard,
 			Description: "Each runbook dashboard must have name and link specified and non-empty",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupRunbook,
+			Long:        "Each runbook dashboard must have a non-empty name and link. The value gives the index of the dashboard.",
+			Rationale:   "A dashboard without a name cannot be shown, and one without a link cannot be opened.",
+			Good:        "runbook:\n  dashboards:\n    - name: Cluster overview\n      link: https://grafana.example.com/d/cluster",
+			Bad:         "runbook:\n  dashboards:\n    - name: Cluster overview",
 		},
 		{
 			ID:          InvalidRunbookDashboardLink,
 			Description: "Dashboard link must be a valid URL with properly defined variables",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupRunbook,
+			Long:        "Each dashboard link must be an http or https URL, and every $VARIABLE it uses must be defined in the runbook variables. The value describes the problem.",
+			Rationale:   "Links with undefined variables or without a scheme lead nowhere when an engineer needs them during an incident.",
+			Good:        "runbook:\n  variables:\n    - name: CLUSTER\n  dashboards:\n    - name: Cluster overview\n      link: https://grafana.example.com/d/cluster?var-cluster=$CLUSTER",
+			Bad:         "runbook:\n  dashboards:\n    - name: Cluster overview\n      link: grafana.example.com/d/cluster?var-cluster=$CLUSTER",
 		},
 		{
 			ID:          InvalidRunbookKnownIssues,
 			Description: "Runbook known issues must be a valid array if present",
 			Severity:    SeverityFail,
+			Group:       GroupRunbook,
+			Long:        "The runbook known issues must be a list.",
+			Rationale:   "The runbook layout iterates over the known issues and cannot render other structures.",
+			Good:        "runbook:\n  known_issues:\n    - url: https://github.com/giantswarm/giantswarm/issues/1234",
+			Bad:         "runbook:\n  known_issues: https://github.com/giantswarm/giantswarm/issues/1234",
 		},
 		{
 			ID:          InvalidRunbookKnownIssue,
 			Description: "Each known issue must have url defined and may have optional description field",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupRunbook,
+			Long:        "Each known issue must have a url and may have a description. The value gives the index of the known issue.",
+			Rationale:   "A known issue without a link gives the reader nothing to follow up on.",
+			Good:        "runbook:\n  known_issues:\n    - url: https://github.com/giantswarm/giantswarm/issues/1234\n      description: Alert fires during upgrades",
+			Bad:         "runbook:\n  known_issues:\n    - description: Alert fires during upgrades",
 		},
 		{
 			ID:          InvalidRunbookKnownIssueURL,
 			Description: "Known issue URL must be a valid URL",
 			Severity:    SeverityFail,
 			HasValue:    true,
+			Group:       GroupRunbook,
+			Long:        "Each known issue url must be an http or https URL. The value is the URL.",
+			Rationale:   "Relative or malformed URLs cannot be opened from the rendered runbook.",
+			Good:        "runbook:\n  known_issues:\n    - url: https://github.com/giantswarm/giantswarm/issues/1234",
+			Bad:         "runbook:\n  known_issues:\n    - url: giantswarm/giantswarm#1234",
 		},
 		{
 			ID:          RunbookAppearsInMenu,
 			Description: "Runbook pages must have toc_hide: true to prevent appearing in menus",
 			Severity:    SeverityFail,
+			Group:       GroupRunbook,
+			Long:        "Pages with layout: runbook must set toc_hide: true.",
+			Rationale:   "Runbooks are reached from alerts, not by browsing, and would clutter the navigation.",
+			Good:        "layout: runbook\ntoc_hide: true",
+			Bad:         "layout: runbook",
+		},
+		// Link checks
+		{
+			ID:          BrokenInternalLink,
+			Description: "The link target does not resolve to a page in the content tree or its aliases",
+			Severity:    SeverityFail,
+			HasValue:    true,
+			Group:       GroupLinks,
+			Long:        "Each internal link, reference link definition and ref or relref shortcode must point to a page in the content tree, either by its path or one of its aliases. The value is the link target.",
+			Rationale:   "Broken links frustrate readers and often remain unnoticed after pages are moved or renamed.",
+			Good:        "See [Install the CLI](/getting-started/install-cli/).",
+			Bad:         "See [Install the CLI](/getting-started/instal-cli/).",
+		},
+		{
+			ID:          BrokenAnchor,
+			Description: "The link points to an anchor that does not exist on the target page",
+			Severity:    SeverityWarn,
+			HasValue:    true,
+			Group:       GroupLinks,
+			Long:        "Each link with an anchor must point to a heading or an element with that ID on the target page. The value is the link target.",
+			Rationale:   "A broken anchor still opens the page, but at the top instead of the relevant section.",
+			Good:        "See [Upgrades](/getting-started/install-cli/#upgrades).",
+			Bad:         "See [Upgrades](/getting-started/install-cli/#upgrading-the-cli).",
 		},
 	}
 }
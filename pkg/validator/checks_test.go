@@ -0,0 +1,40 @@
+package validator
+
+import "testing"
+
+func TestGetChecks_Explanations(t *testing.T) {
+	groups := map[string]bool{
+		GroupPrerequisites: true,
+		GroupTitle:         true,
+		GroupDescription:   true,
+		GroupNavigation:    true,
+		GroupOwner:         true,
+		GroupReview:        true,
+		GroupDates:         true,
+		GroupValues:        true,
+		GroupUserQuestions: true,
+		GroupDiataxis:      true,
+		GroupRunbook:       true,
+		GroupLinks:         true,
+	}
+
+	seen := make(map[string]bool)
+	for _, check := range GetChecks() {
+		t.Run(check.ID, func(t *testing.T) {
+			if seen[check.ID] {
+				t.Errorf("duplicate check ID")
+			}
+			seen[check.ID] = true
+
+			if !groups[check.Group] {
+				t.Errorf("unknown group %q", check.Group)
+			}
+			if check.Long == "" || check.Rationale == "" {
+				t.Errorf("missing long description or rationale")
+			}
+			if check.Good == "" || check.Bad == "" {
+				t.Errorf("missing good or bad example")
+			}
+		})
+	}
+}
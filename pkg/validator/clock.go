@@ -0,0 +1,30 @@
+package validator
+
+import "time"
+
+// Clock provides the current time to date-dependent checks
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to the Clock interface
+type ClockFunc func() time.Time
+
+// Now returns the current time
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock is the Clock used unless another one is configured
+var SystemClock Clock = ClockFunc(time.Now)
+
+// FixedClock returns a Clock that always reports the given time
+func FixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time { return t })
+}
+
+// CalendarDate returns the calendar date of a time as written, at midnight UTC.
+// Dates compared this way are independent of the time of day.
+func CalendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
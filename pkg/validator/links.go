@@ -0,0 +1,455 @@
+package validator
+
+import (
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	// Inline Markdown links and images: [text](target "optional title")
+	inlineLinkRegex = regexp.MustCompile(`\]\(\s*<?([^)\s>]+)>?(?:\s+["'(][^)]*)?\)`)
+	// Reference-style link definitions: [label]: target
+	referenceLinkRegex = regexp.MustCompile(`^\s{0,3}\[[^\]]+\]:\s*<?([^\s>]+)>?`)
+	// Hugo ref and relref shortcodes, with either delimiter style
+	refShortcodeRegex = regexp.MustCompile(`\{\{[<%]\s*(?:rel)?ref\s+["` + "`" + `]([^"` + "`" + `]*)["` + "`" + `]\s*[>%]\}\}`)
+	// ATX headings, with an optional {#custom-id} attribute
+	atxHeadingRegex = regexp.MustCompile(`^\s{0,3}#{1,6}\s+(.*?)\s*#*\s*$`)
+	// Setext heading underlines
+	setextUnderlineRegex = regexp.MustCompile(`^\s{0,3}(=+|-+)\s*$`)
+	// Explicit heading IDs: ## Heading {#custom-id}
+	headingIDRegex = regexp.MustCompile(`\s*\{#([^}\s]+)[^}]*\}\s*$`)
+	// HTML elements carrying an id or name attribute
+	htmlAnchorRegex = regexp.MustCompile(`(?i)<[a-z][^>]*\s(?:id|name)\s*=\s*["']([^"']+)["']`)
+	// Inline code spans, removed before extracting links
+	codeSpanRegex = regexp.MustCompile("`+[^`]*`+")
+	// Markdown links inside heading text, reduced to their label
+	headingLinkRegex = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+	// URL schemes such as https:, mailto: or tel:
+	schemeRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+)
+
+// linkPage is a single content page known to the link index
+type linkPage struct {
+	file    string
+	url     string
+	anchors map[string]bool
+}
+
+// linkIndex resolves internal links against the scanned content tree
+type linkIndex struct {
+	byURL      map[string]*linkPage
+	byFile     map[string]*linkPage
+	byBaseName map[string][]*linkPage
+}
+
+// link is an internal link found in a page body
+type link struct {
+	target   string
+	line     int
+	shortcut bool // true for ref/relref shortcodes
+}
+
+// ContentPath returns the path of a file relative to the Hugo content directory.
+// The content directory is the first path segment named "content", as in
+// "src/content/docs/page.md". Paths without such a segment are returned unchanged.
+func ContentPath(filePath string) string {
+	root := contentRoot(filePath)
+	p := normalizePath(filePath)
+	if root == "" {
+		return p
+	}
+	return strings.TrimPrefix(p, root+"/")
+}
+
+// contentRoot returns the Hugo content directory a file belongs to, or an empty
+// string if the path contains no "content" segment
+func contentRoot(filePath string) string {
+	segments := strings.Split(normalizePath(filePath), "/")
+	for i, segment := range segments[:len(segments)-1] {
+		if segment == "content" {
+			return strings.Join(segments[:i+1], "/")
+		}
+	}
+	return ""
+}
+
+// normalizePath cleans a file path and converts it to forward slashes
+func normalizePath(filePath string) string {
+	p := path.Clean(strings.ReplaceAll(filePath, "\\", "/"))
+	return strings.TrimPrefix(p, "./")
+}
+
+// isSectionPage reports whether a content file is a Hugo section page, like
+// "_index.md" or "_index.markdown"
+func isSectionPage(filePath string) bool {
+	base := path.Base(normalizePath(filePath))
+	return strings.TrimSuffix(base, path.Ext(base)) == "_index"
+}
+
+// pageURL returns the URL path Hugo publishes a content file at, without the
+// extension of the file
+func pageURL(filePath string) string {
+	rel := ContentPath(filePath)
+	rel = strings.TrimSuffix(rel, path.Ext(rel))
+	base := path.Base(rel)
+	if base == "_index" || base == "index" {
+		rel = path.Dir(rel)
+	}
+	return normalizeURL("/" + rel)
+}
+
+// normalizeURL brings a URL path into the form used as index key
+func normalizeURL(u string) string {
+	u = path.Clean("/" + strings.ToLower(u))
+	if u == "/" {
+		return u
+	}
+	return u + "/"
+}
+
+// ValidateLinks checks internal links, ref/relref shortcodes and anchors across
+// a set of files. The map keys are file paths and the values their content. Links
+// are resolved against the given files and their aliases only, so the result is
+// most accurate when the whole content tree is passed. The returned map only
+// contains files with findings.
+func (v *Validator) ValidateLinks(files map[string]string) map[string][]CheckResult {
+	return v.ValidateLinksInTree(files, files)
+}
+
+// ValidateLinksInTree checks the links of files like ValidateLinks, but
+// resolves them against tree, the content of all pages links may point to,
+// keyed by file path. This way, a subset of the content tree can be checked.
+// Files missing from tree are added to it.
+func (v *Validator) ValidateLinksInTree(files, tree map[string]string) map[string][]CheckResult {
+	pages := tree
+	for filePath := range files {
+		if _, ok := tree[filePath]; !ok {
+			pages = make(map[string]string, len(tree)+len(files))
+			for p, content := range tree {
+				pages[p] = content
+			}
+			for p, content := range files {
+				pages[p] = content
+			}
+			break
+		}
+	}
+
+	index := v.buildLinkIndex(pages)
+	results := make(map[string][]CheckResult)
+
+	filePaths := make([]string, 0, len(files))
+	for filePath := range files {
+		filePaths = append(filePaths, filePath)
+	}
+	sort.Strings(filePaths)
+
+	for _, filePath := range filePaths {
+		if v.configManager != nil && v.configManager.IsPathIgnored(filePath) {
+			continue
+		}
+		skipLinks := v.shouldSkipCheck(filePath, BrokenInternalLink)
+		skipAnchors := v.shouldSkipCheck(filePath, BrokenAnchor)
+		if skipLinks && skipAnchors {
+			continue
+		}
+
+		page := index.byFile[normalizePath(filePath)]
+		body, offset := splitBody(files[filePath])
+
+		for _, l := range extractLinks(body, offset) {
+			target, anchor := splitAnchor(l.target)
+
+			var resolved *linkPage
+			var ok bool
+			if l.shortcut {
+				resolved, ok = index.resolveRef(page, target)
+			} else {
+				resolved, ok = index.resolveLink(page, target)
+			}
+			if !ok {
+				// External link or static asset, nothing to verify
+				continue
+			}
+
+			if resolved == nil {
+				if !skipLinks {
+					results[filePath] = append(results[filePath], CheckResult{
+						Check: BrokenInternalLink,
+						Value: l.target,
+						Line:  l.line,
+					})
+				}
+				continue
+			}
+
+			if anchor != "" && !resolved.anchors[anchor] && !skipAnchors {
+				results[filePath] = append(results[filePath], CheckResult{
+					Check: BrokenAnchor,
+					Value: l.target,
+					Line:  l.line,
+				})
+			}
+		}
+	}
+
+	return results
+}
+
+// buildLinkIndex registers every file with its URL, aliases and anchors
+func (v *Validator) buildLinkIndex(files map[string]string) *linkIndex {
+	index := &linkIndex{
+		byURL:      make(map[string]*linkPage),
+		byFile:     make(map[string]*linkPage),
+		byBaseName: make(map[string][]*linkPage),
+	}
+
+	for filePath, content := range files {
+		body, _ := splitBody(content)
+		page := &linkPage{
+			file:    normalizePath(filePath),
+			url:     pageURL(filePath),
+			anchors: extractAnchors(body),
+		}
+		index.byFile[page.file] = page
+		index.byURL[page.url] = page
+		index.byBaseName[path.Base(page.file)] = append(index.byBaseName[path.Base(page.file)], page)
+
+		fm, _, _, err := v.parseFrontMatter(content)
+		if err != nil || fm == nil {
+			continue
+		}
+		for _, alias := range fm.Aliases {
+			if !strings.HasPrefix(alias, "/") {
+				alias = path.Join(path.Dir(strings.TrimSuffix(page.url, "/")), alias)
+			}
+			if _, exists := index.byURL[normalizeURL(alias)]; !exists {
+				index.byURL[normalizeURL(alias)] = page
+			}
+		}
+	}
+
+	return index
+}
+
+// resolveLink resolves a Markdown link target. The second return value is false
+// for links that cannot be checked offline, such as external URLs or assets.
+func (idx *linkIndex) resolveLink(page *linkPage, target string) (*linkPage, bool) {
+	if schemeRegex.MatchString(target) || strings.HasPrefix(target, "//") {
+		return nil, false
+	}
+	if target == "" {
+		return page, page != nil
+	}
+
+	// Directory-style URLs like "/changes/app/v1.2.0/" are pages, not assets
+	ext := ""
+	if !strings.HasSuffix(target, "/") {
+		ext = path.Ext(target)
+	}
+	switch {
+	case ext == ".md":
+		var candidate string
+		if strings.HasPrefix(target, "/") {
+			candidate = path.Join(contentRoot(page.file), strings.TrimPrefix(target, "/"))
+		} else {
+			candidate = path.Join(path.Dir(page.file), target)
+		}
+		return idx.byFile[normalizePath(candidate)], true
+	case ext != "":
+		return nil, false
+	}
+
+	u := target
+	if !strings.HasPrefix(u, "/") {
+		u = path.Join(page.url, u)
+	}
+	return idx.byURL[normalizeURL(u)], true
+}
+
+// resolveRef resolves the path argument of a ref or relref shortcode the way
+// Hugo does: absolute paths are relative to the content directory, other paths
+// are relative to the page, and a unique file name is looked up site-wide.
+func (idx *linkIndex) resolveRef(page *linkPage, target string) (*linkPage, bool) {
+	if target == "" {
+		return page, page != nil
+	}
+
+	var base string
+	if strings.HasPrefix(target, "/") {
+		base = path.Join(contentRoot(page.file), strings.TrimPrefix(target, "/"))
+	} else {
+		base = path.Join(path.Dir(page.file), target)
+	}
+
+	candidates := []string{base}
+	if path.Ext(base) != ".md" {
+		candidates = append(candidates, base+".md", base+"/_index.md", base+"/index.md")
+	}
+	for _, candidate := range candidates {
+		if resolved, ok := idx.byFile[normalizePath(candidate)]; ok {
+			return resolved, true
+		}
+	}
+
+	if !strings.Contains(target, "/") {
+		name := target
+		if path.Ext(name) != ".md" {
+			name += ".md"
+		}
+		if matches := idx.byBaseName[name]; len(matches) == 1 {
+			return matches[0], true
+		}
+	}
+
+	return nil, true
+}
+
+// splitBody returns the content after the front matter block, along with the
+// number of lines preceding it
+func splitBody(content string) (string, int) {
+	if !strings.HasPrefix(content, "---\n") {
+		return content, 0
+	}
+	end := strings.Index(content[4:], "\n---\n")
+	if end < 0 {
+		return content, 0
+	}
+	bodyStart := 4 + end + len("\n---\n")
+	return content[bodyStart:], strings.Count(content[:bodyStart], "\n")
+}
+
+// splitAnchor separates the fragment from a link target and drops any query
+func splitAnchor(target string) (string, string) {
+	anchor := ""
+	if i := strings.Index(target, "#"); i >= 0 {
+		anchor = target[i+1:]
+		target = target[:i]
+	}
+	if i := strings.Index(target, "?"); i >= 0 {
+		target = target[:i]
+	}
+	return target, anchor
+}
+
+// extractLinks returns all links and ref/relref shortcodes in a page body,
+// ignoring fenced code blocks and inline code
+func extractLinks(body string, lineOffset int) []link {
+	var links []link
+
+	forEachProseLine(body, func(lineNo int, text string) {
+		lineNo += lineOffset
+		// Shortcodes can quote their argument with backticks, so code spans
+		// are only skipped when they contain the start of the shortcode
+		spans := codeSpanRegex.FindAllStringIndex(text, -1)
+		for _, match := range refShortcodeRegex.FindAllStringSubmatchIndex(text, -1) {
+			if !inSpan(spans, match[0]) {
+				links = append(links, link{target: text[match[2]:match[3]], line: lineNo, shortcut: true})
+			}
+		}
+		text = codeSpanRegex.ReplaceAllString(text, "")
+		for _, match := range inlineLinkRegex.FindAllStringSubmatch(text, -1) {
+			if strings.HasPrefix(match[1], "{{") {
+				continue
+			}
+			links = append(links, link{target: match[1], line: lineNo})
+		}
+		if match := referenceLinkRegex.FindStringSubmatch(text); match != nil && !strings.HasPrefix(match[1], "{{") {
+			links = append(links, link{target: match[1], line: lineNo})
+		}
+	})
+
+	return links
+}
+
+// inSpan reports whether an index lies within one of the spans
+func inSpan(spans [][]int, i int) bool {
+	for _, span := range spans {
+		if i >= span[0] && i < span[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// extractAnchors returns the set of anchors a page body provides, from headings
+// and from HTML id and name attributes
+func extractAnchors(body string) map[string]bool {
+	anchors := make(map[string]bool)
+	seen := make(map[string]int)
+
+	addHeading := func(text string) {
+		if match := headingIDRegex.FindStringSubmatch(text); match != nil {
+			anchors[match[1]] = true
+			return
+		}
+		id := anchorize(text)
+		if n, exists := seen[id]; exists {
+			seen[id] = n + 1
+			id = id + "-" + strconv.Itoa(n+1)
+		} else {
+			seen[id] = 0
+		}
+		anchors[id] = true
+	}
+
+	previous := ""
+	forEachProseLine(body, func(_ int, text string) {
+		if match := atxHeadingRegex.FindStringSubmatch(text); match != nil {
+			addHeading(match[1])
+			previous = ""
+			return
+		}
+		if setextUnderlineRegex.MatchString(text) && strings.TrimSpace(previous) != "" {
+			addHeading(strings.TrimSpace(previous))
+			previous = ""
+			return
+		}
+		for _, match := range htmlAnchorRegex.FindAllStringSubmatch(text, -1) {
+			anchors[match[1]] = true
+		}
+		previous = text
+	})
+
+	return anchors
+}
+
+// forEachProseLine calls fn for every line outside of fenced code blocks. Line
+// numbers are 1-based.
+func forEachProseLine(body string, fn func(lineNo int, text string)) {
+	fence := ""
+	for i, text := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(text)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+		fn(i+1, text)
+	}
+}
+
+// anchorize converts heading text into the ID Hugo generates for it
+func anchorize(text string) string {
+	text = headingLinkRegex.ReplaceAllString(text, "$1")
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-':
+			b.WriteRune(r)
+		case unicode.IsSpace(r):
+			b.WriteRune('-')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,248 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateLinks(t *testing.T) {
+	linkChecks := &mockConfigManager{
+		defaultChecks: []string{BrokenInternalLink, BrokenAnchor},
+	}
+
+	site := map[string]string{
+		"src/content/docs/_index.md": "---\ntitle: Docs\n---\n\n# Overview\n",
+		"src/content/docs/getting-started/index.md": `---
+title: Getting started
+aliases:
+  - /old/getting-started/
+---
+
+## Install the CLI
+
+Some text.
+
+## Configure {#config}
+
+<a id="manual-anchor"></a>
+`,
+		"src/content/docs/tutorials/first-cluster.md": "---\ntitle: First cluster\n---\n\n## Create a cluster\n\n## Create a cluster\n",
+		"src/content/changes/app/v1.2.0.md":           "---\ntitle: App v1.2.0\n---\n",
+		"src/content/docs/reference/_index.markdown":  "---\ntitle: Reference\n---\n",
+		"src/content/docs/reference/api.mdx":          "---\ntitle: API\n---\n",
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		wantChecks []string
+		wantValues []interface{}
+		wantLines  []int
+	}{
+		{
+			name: "valid absolute, relative and alias links",
+			body: "[a](/docs/getting-started/)\n[b](../../getting-started/)\n[c](/old/getting-started/)\n[d](/docs/)\n",
+		},
+		{
+			name: "pages with other content extensions",
+			body: "[a](/docs/reference/)\n[b](/docs/reference/api/)\n",
+		},
+		{
+			name:       "missing page",
+			body:       "Text\n\n[broken](/docs/does-not-exist/)\n",
+			wantChecks: []string{BrokenInternalLink},
+			wantValues: []interface{}{"/docs/does-not-exist/"},
+			wantLines:  []int{7},
+		},
+		{
+			name:       "relative Markdown file links",
+			body:       "[ok](first-cluster.md)\n[broken](missing.md)\n",
+			wantChecks: []string{BrokenInternalLink},
+			wantValues: []interface{}{"missing.md"},
+			wantLines:  []int{6},
+		},
+		{
+			name: "relref and ref shortcodes",
+			body: `[a]({{< relref "/docs/getting-started" >}})
+[b]({{< relref "first-cluster.md" >}})
+[c]({{% ref "/docs/tutorials/missing.md" %}})
+[d]({{< relref "v1.2.0.md" >}})
+`,
+			wantChecks: []string{BrokenInternalLink},
+			wantValues: []interface{}{"/docs/tutorials/missing.md"},
+			wantLines:  []int{7},
+		},
+		{
+			name:       "shortcodes in inline code are ignored",
+			body:       "`{{< ref \"/docs/missing\" >}}`\n[a]({{< relref `/docs/missing.md` >}})\n",
+			wantChecks: []string{BrokenInternalLink},
+			wantValues: []interface{}{"/docs/missing.md"},
+			wantLines:  []int{6},
+		},
+		{
+			name: "anchors from headings, custom IDs and HTML",
+			body: `[a](/docs/getting-started/#install-the-cli)
+[b]({{< relref "/docs/getting-started#config" >}})
+[c](/docs/getting-started/#manual-anchor)
+[d](#create-a-cluster-1)
+[e](/docs/getting-started/#missing)
+[f](#nope)
+`,
+			wantChecks: []string{BrokenAnchor, BrokenAnchor},
+			wantValues: []interface{}{"/docs/getting-started/#missing", "#nope"},
+			wantLines:  []int{9, 10},
+		},
+		{
+			name: "external links, assets and code are ignored",
+			body: "[a](https://example.com/missing/)\n[b](mailto:docs@example.com)\n![img](/img/missing.png)\n`[c](/missing/)`\n\n```\n[d](/missing/)\n```\n",
+		},
+		{
+			name:       "version directories are pages",
+			body:       "[a](/changes/app/v1.2.0/)\n[b](/changes/app/v9.9.9/)\n",
+			wantChecks: []string{BrokenInternalLink},
+			wantValues: []interface{}{"/changes/app/v9.9.9/"},
+			wantLines:  []int{6},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := make(map[string]string)
+			for filePath, content := range site {
+				files[filePath] = content
+			}
+			const filePath = "src/content/docs/tutorials/page.md"
+			// The page body starts at line 5
+			files[filePath] = "---\ntitle: Page\n---\n\n" + tt.body + "\n## Create a cluster\n\n## Create a cluster\n"
+
+			v := NewWithConfig(linkChecks)
+			results := v.ValidateLinks(files)
+
+			for other := range results {
+				if other != filePath {
+					t.Errorf("Unexpected findings for %s: %v", other, results[other])
+				}
+			}
+
+			var gotChecks []string
+			var gotValues []interface{}
+			var gotLines []int
+			for _, check := range results[filePath] {
+				gotChecks = append(gotChecks, check.Check)
+				gotValues = append(gotValues, check.Value)
+				gotLines = append(gotLines, check.Line)
+			}
+
+			if !reflect.DeepEqual(gotChecks, tt.wantChecks) {
+				t.Errorf("Expected checks %v, got %v", tt.wantChecks, gotChecks)
+			}
+			if !reflect.DeepEqual(gotValues, tt.wantValues) {
+				t.Errorf("Expected values %v, got %v", tt.wantValues, gotValues)
+			}
+			if !reflect.DeepEqual(gotLines, tt.wantLines) {
+				t.Errorf("Expected lines %v, got %v", tt.wantLines, gotLines)
+			}
+		})
+	}
+}
+
+func TestValidateLinks_ConfigDisablesChecks(t *testing.T) {
+	files := map[string]string{
+		"src/content/a.md": "---\ntitle: A\n---\n\n[x](/missing/)\n[y](#missing)\n",
+	}
+
+	tests := []struct {
+		name    string
+		enabled []string
+		want    []string
+	}{
+		{
+			name:    "both enabled",
+			enabled: []string{BrokenInternalLink, BrokenAnchor},
+			want:    []string{BrokenInternalLink, BrokenAnchor},
+		},
+		{
+			name:    "only anchors",
+			enabled: []string{BrokenAnchor},
+			want:    []string{BrokenAnchor},
+		},
+		{
+			name:    "none enabled",
+			enabled: []string{NoTitle},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewWithConfig(&mockConfigManager{defaultChecks: tt.enabled})
+			got := getCheckIDs(v.ValidateLinks(files)["src/content/a.md"])
+			if len(got) == 0 && len(tt.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateLinksInTree(t *testing.T) {
+	tree := map[string]string{
+		"src/content/a/_index.md": "---\ntitle: A\n---\n\n[b](/b/#setup)\n",
+		"src/content/b/_index.md": "---\ntitle: B\n---\n\n## Setup\n",
+	}
+	files := map[string]string{
+		"./src/content/c.md": "---\ntitle: C\n---\n\n[a](/a/) [b](/b/#setup) [missing](/missing/) [self](/c/)\n",
+	}
+
+	v := NewWithConfig(&mockConfigManager{defaultChecks: []string{BrokenInternalLink, BrokenAnchor}})
+	results := v.ValidateLinksInTree(files, tree)
+	if len(results) != 1 {
+		t.Fatalf("Expected findings for one file, got %v", results)
+	}
+	checks := results["./src/content/c.md"]
+	if len(checks) != 1 || checks[0].Check != BrokenInternalLink || checks[0].Value != "/missing/" {
+		t.Errorf("Expected only the link to /missing/ to be broken, got %+v", checks)
+	}
+}
+
+func TestContentPath(t *testing.T) {
+	tests := []struct {
+		filePath string
+		want     string
+	}{
+		{"src/content/docs/page.md", "docs/page.md"},
+		{"./content/docs/page.md", "docs/page.md"},
+		{"/abs/site/content/a/_index.md", "a/_index.md"},
+		{"docs/page.md", "docs/page.md"},
+		{"content.md", "content.md"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filePath, func(t *testing.T) {
+			if got := ContentPath(tt.filePath); got != tt.want {
+				t.Errorf("ContentPath(%q) = %q, want %q", tt.filePath, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnchorize(t *testing.T) {
+	tests := []struct {
+		heading string
+		want    string
+	}{
+		{"Install the CLI", "install-the-cli"},
+		{"What's new in v1.2?", "whats-new-in-v12"},
+		{"Use `kubectl` with [Teleport](https://goteleport.com)", "use-kubectl-with-teleport"},
+		{"snake_case and dash-case", "snake_case-and-dash-case"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.heading, func(t *testing.T) {
+			if got := anchorize(tt.heading); got != tt.want {
+				t.Errorf("anchorize(%q) = %q, want %q", tt.heading, got, tt.want)
+			}
+		})
+	}
+}
@@ -54,6 +54,36 @@ const (
 	InvalidRunbookKnownIssue    = "INVALID_RUNBOOK_KNOWN_ISSUE"
 	InvalidRunbookKnownIssueURL = "INVALID_RUNBOOK_KNOWN_ISSUE_URL"
 	RunbookAppearsInMenu        = "RUNBOOK_APPEARS_IN_MENU"
+	// Date format checks
+	NonISODate  = "NON_ISO_DATE"
+	InvalidDate = "INVALID_DATE"
+	// Value range checks
+	InvalidExpirationInDays = "INVALID_EXPIRATION_IN_DAYS"
+	FutureDate              = "FUTURE_DATE"
+	InvalidWeight           = "INVALID_WEIGHT"
+	// Link checks
+	BrokenInternalLink = "BROKEN_INTERNAL_LINK"
+	BrokenAnchor       = "BROKEN_ANCHOR"
+)
+
+// DefaultExpirationInDays is the review interval for pages without expiration_in_days
+const DefaultExpirationInDays = 365
+
+// Bounds is an inclusive range of allowed integer values
+type Bounds struct {
+	Min int
+	Max int
+}
+
+// Contains reports whether a value lies within the bounds
+func (b Bounds) Contains(value int) bool {
+	return value >= b.Min && value <= b.Max
+}
+
+// Default bounds for numeric frontmatter values
+var (
+	DefaultExpirationBounds = Bounds{Min: 1, Max: 1095}
+	DefaultWeightBounds     = Bounds{Min: -100000, Max: 100000}
 )
 
 // Severity levels
@@ -62,33 +92,77 @@ const (
 	SeverityWarn = "WARN"
 )
 
+// Check groups, as used to organize checks in listings and documentation
+const (
+	GroupPrerequisites = "prerequisites"
+	GroupTitle         = "title"
+	GroupDescription   = "description"
+	GroupNavigation    = "navigation"
+	GroupOwner         = "owner"
+	GroupReview        = "review"
+	GroupDates         = "dates"
+	GroupValues        = "values"
+	GroupUserQuestions = "user-questions"
+	GroupDiataxis      = "diataxis"
+	GroupRunbook       = "runbook"
+	GroupLinks         = "links"
+)
+
 // Check represents a validation check
 type Check struct {
 	ID          string `json:"id"`
 	Description string `json:"description"`
 	Severity    string `json:"severity"`
 	HasValue    bool   `json:"has_value,omitempty"`
+	Group       string `json:"group"`
+	// Long explains in detail what the check looks at
+	Long string `json:"long,omitempty"`
+	// Rationale explains why the check exists
+	Rationale string `json:"rationale,omitempty"`
+	// Good and Bad are examples of content that passes and fails the check
+	Good string `json:"good,omitempty"`
+	Bad  string `json:"bad,omitempty"`
 }
 
 // CheckResult represents the result of a single validation check
 type CheckResult struct {
-	Check   string      `json:"check"`
-	Value   interface{} `json:"value,omitempty"`
-	Line    int         `json:"line,omitempty"`
-	EndLine int         `json:"end_line,omitempty"`
-	Title   string      `json:"title,omitempty"`
-	Owner   []string    `json:"owner,omitempty"`
+	Check string      `json:"check"`
+	Value interface{} `json:"value,omitempty"`
+	// Message replaces the description of the check, like to name the
+	// configured range of a value
+	Message string   `json:"message,omitempty"`
+	Line    int      `json:"line,omitempty"`
+	EndLine int      `json:"end_line,omitempty"`
+	Title   string   `json:"title,omitempty"`
+	Owner   []string `json:"owner,omitempty"`
 }
 
 // ValidationResult represents the result of validating a single file
 type ValidationResult struct {
 	NumFrontMatterLines int           `json:"num_front_matter_lines"`
 	Checks              []CheckResult `json:"checks"`
+	Owner               []string      `json:"owner,omitempty"`
+	FrontMatter         *FrontMatter  `json:"-"`
 }
 
+// Date layouts accepted by FlexibleDate
+const (
+	DateLayoutISO      = "2006-01-02" // YYYY-MM-DD (most common)
+	DateLayoutUSSlash  = "01/02/2006" // MM/DD/YYYY
+	DateLayoutEUSlash  = "02/01/2006" // DD/MM/YYYY
+	dateLayoutRFC3339  = "2006-01-02T15:04:05Z07:00"
+	dateLayoutUTC      = "2006-01-02T15:04:05Z"
+	dateLayoutNoZone   = "2006-01-02T15:04:05"
+	dateLayoutSpaceSep = "2006-01-02 15:04:05"
+)
+
 // FlexibleDate is a custom type that can parse various date formats
 type FlexibleDate struct {
 	time.Time
+	// Raw is the value as written in the frontmatter
+	Raw string
+	// Layout is the layout the value was parsed with
+	Layout string
 }
 
 // UnmarshalYAML implements custom YAML unmarshaling for flexible date parsing
@@ -98,15 +172,16 @@ func (fd *FlexibleDate) UnmarshalYAML(value *yaml.Node) error {
 	// with the !!timestamp tag, which can no longer be decoded into a string.
 	dateStr := value.Value
 
-	// Try parsing various date formats
+	// Try parsing various date formats. The slash formats are ambiguous, and
+	// MM/DD/YYYY wins over DD/MM/YYYY when both would parse.
 	formats := []string{
-		"2006-01-02",                // YYYY-MM-DD (most common)
-		"2006-01-02T15:04:05Z07:00", // RFC3339 (full timestamp)
-		"2006-01-02T15:04:05Z",      // RFC3339 UTC
-		"2006-01-02T15:04:05",       // ISO 8601 without timezone
-		"2006-01-02 15:04:05",       // Space separated
-		"01/02/2006",                // MM/DD/YYYY
-		"02/01/2006",                // DD/MM/YYYY
+		DateLayoutISO,
+		dateLayoutRFC3339,  // RFC3339 (full timestamp)
+		dateLayoutUTC,      // RFC3339 UTC
+		dateLayoutNoZone,   // ISO 8601 without timezone
+		dateLayoutSpaceSep, // Space separated
+		DateLayoutUSSlash,
+		DateLayoutEUSlash,
 	}
 
 	// Clean up the date string
@@ -115,6 +190,8 @@ func (fd *FlexibleDate) UnmarshalYAML(value *yaml.Node) error {
 	for _, format := range formats {
 		if t, err := time.Parse(format, dateStr); err == nil {
 			fd.Time = t
+			fd.Raw = dateStr
+			fd.Layout = format
 			return nil
 		}
 	}
@@ -122,6 +199,27 @@ func (fd *FlexibleDate) UnmarshalYAML(value *yaml.Node) error {
 	return fmt.Errorf("unable to parse date %q: supported formats are YYYY-MM-DD, RFC3339, etc.", dateStr)
 }
 
+// IsISODate reports whether the value was written as a plain YYYY-MM-DD date
+func (fd FlexibleDate) IsISODate() bool {
+	return fd.Layout == DateLayoutISO
+}
+
+// IsISO reports whether the value was written as a YYYY-MM-DD date or an
+// ISO 8601 timestamp starting with one
+func (fd FlexibleDate) IsISO() bool {
+	switch fd.Layout {
+	case DateLayoutISO, dateLayoutRFC3339, dateLayoutUTC, dateLayoutNoZone:
+		return true
+	}
+	return false
+}
+
+// IsAmbiguous reports whether the value uses one of the slash formats, where
+// the order of day and month cannot be told from the value alone
+func (fd FlexibleDate) IsAmbiguous() bool {
+	return fd.Layout == DateLayoutUSSlash || fd.Layout == DateLayoutEUSlash
+}
+
 // MarshalYAML implements custom YAML marshaling
 func (fd FlexibleDate) MarshalYAML() (interface{}, error) {
 	return fd.Time.Format("2006-01-02"), nil
@@ -182,9 +280,12 @@ type FrontMatter struct {
 
 // JSONOutput represents the JSON output format for issues
 type JSONOutput struct {
-	Title   string   `json:"title"`
-	Message string   `json:"message"`
-	Owner   []string `json:"owner"`
+	Title   string `json:"title"`
+	Message string `json:"message"`
+	// Owner holds the issue labels resolved from the page owners
+	Owner     []string `json:"owner"`
+	Assignees []string `json:"assignees,omitempty"`
+	Projects  []string `json:"projects,omitempty"`
 }
 
 // Annotation represents a GitHub Actions annotation
@@ -14,6 +14,51 @@ type Validator struct {
 	checks        []Check
 	validKeys     map[string]bool
 	configManager ConfigManager
+	clock         Clock
+	location      *time.Location
+	strictDates   bool
+	expiration    Bounds
+	weight        Bounds
+}
+
+// Option configures optional Validator behavior
+type Option func(*Validator)
+
+// WithClock sets the clock used to determine today's date for date checks
+func WithClock(clock Clock) Option {
+	return func(v *Validator) {
+		v.clock = clock
+	}
+}
+
+// WithStrictDates rejects the ambiguous MM/DD/YYYY and DD/MM/YYYY date formats.
+// An ambiguous last_review_date is then reported as INVALID_LAST_REVIEW_DATE,
+// and an ambiguous date as INVALID_DATE.
+func WithStrictDates(strict bool) Option {
+	return func(v *Validator) {
+		v.strictDates = strict
+	}
+}
+
+// WithExpirationBounds sets the allowed range for expiration_in_days values
+func WithExpirationBounds(bounds Bounds) Option {
+	return func(v *Validator) {
+		v.expiration = bounds
+	}
+}
+
+// WithWeightBounds sets the allowed range for weight values
+func WithWeightBounds(bounds Bounds) Option {
+	return func(v *Validator) {
+		v.weight = bounds
+	}
+}
+
+// WithLocation sets the timezone in which today's date is determined
+func WithLocation(location *time.Location) Option {
+	return func(v *Validator) {
+		v.location = location
+	}
 }
 
 // ConfigManager interface for configuration management
@@ -23,14 +68,10 @@ type ConfigManager interface {
 }
 
 // New creates a new Validator instance with default configuration
-func New() *Validator {
+func New(opts ...Option) *Validator {
 	// Create a default config manager for backward compatibility
 	configManager, _ := createDefaultConfigManager()
-	return &Validator{
-		checks:        GetChecks(),
-		validKeys:     GetValidKeys(),
-		configManager: configManager,
-	}
+	return NewWithConfig(configManager, opts...)
 }
 
 // NewWithExcludes creates a new Validator instance with default configuration
@@ -38,11 +79,7 @@ func New() *Validator {
 func NewWithExcludes(excludePatterns []string) *Validator {
 	// Create a default config manager for backward compatibility
 	configManager, _ := createDefaultConfigManager()
-	return &Validator{
-		checks:        GetChecks(),
-		validKeys:     GetValidKeys(),
-		configManager: configManager,
-	}
+	return NewWithConfig(configManager)
 }
 
 // createDefaultConfigManager creates a config manager with default configuration
@@ -60,58 +97,30 @@ func (dcm *defaultConfigManager) IsPathIgnored(filePath string) bool {
 }
 
 func (dcm *defaultConfigManager) GetEnabledChecksForPath(filePath string) []string {
-	// Return all checks enabled by default - this matches the old behavior
-	// where all checks were enabled unless specifically ignored
-	return []string{
-		"NO_FRONT_MATTER",
-		"NO_TRAILING_NEWLINE",
-		"UNKNOWN_ATTRIBUTE",
-		"NO_TITLE",
-		"LONG_TITLE",
-		"SHORT_TITLE",
-		"NO_DESCRIPTION",
-		"LONG_DESCRIPTION",
-		"SHORT_DESCRIPTION",
-		"NO_FULL_STOP_DESCRIPTION",
-		"INVALID_DESCRIPTION",
-		"NO_LINK_TITLE",
-		"LONG_LINK_TITLE",
-		"NO_WEIGHT",
-		"NO_OWNER",
-		"INVALID_OWNER",
-		"NO_LAST_REVIEW_DATE",
-		"REVIEW_TOO_LONG_AGO",
-		"INVALID_LAST_REVIEW_DATE",
-		"NO_USER_QUESTIONS",
-		"LONG_USER_QUESTION",
-		"NO_QUESTION_MARK",
-		// Diátaxis checks. INVALID_DIATAXIS_CONTENT_TYPE is safe to enable by default
-		// (it only fires when the field is present with a bad value). NO_DIATAXIS_CONTENT_TYPE
-		// is intentionally opt-in — enable it per repo/directory once pages are tagged.
-		"INVALID_DIATAXIS_CONTENT_TYPE",
-		// Runbook checks
-		"RUNBOOK_LAYOUT_NOT_SET",
-		"INVALID_RUNBOOK_VARIABLES",
-		"RUNBOOK_VARIABLE_WITHOUT_NAME",
-		"INVALID_RUNBOOK_VARIABLE_NAME",
-		"INVALID_RUNBOOK_VARIABLE",
-		"INVALID_RUNBOOK_DASHBOARDS",
-		"INVALID_RUNBOOK_DASHBOARD",
-		"INVALID_RUNBOOK_DASHBOARD_LINK",
-		"INVALID_RUNBOOK_KNOWN_ISSUES",
-		"INVALID_RUNBOOK_KNOWN_ISSUE",
-		"INVALID_RUNBOOK_KNOWN_ISSUE_URL",
-		"RUNBOOK_APPEARS_IN_MENU",
-	}
+	return DefaultEnabledChecks()
 }
 
 // NewWithConfig creates a new Validator instance with a configuration manager
-func NewWithConfig(configManager ConfigManager) *Validator {
-	return &Validator{
+func NewWithConfig(configManager ConfigManager, opts ...Option) *Validator {
+	v := &Validator{
 		checks:        GetChecks(),
 		validKeys:     GetValidKeys(),
 		configManager: configManager,
+		clock:         SystemClock,
+		location:      time.Local,
+		expiration:    DefaultExpirationBounds,
+		weight:        DefaultWeightBounds,
+	}
+	for _, opt := range opts {
+		opt(v)
 	}
+	return v
+}
+
+// Today returns the current calendar date in the configured timezone, as used
+// by the date checks
+func (v *Validator) Today() time.Time {
+	return CalendarDate(v.clock.Now().In(v.location))
 }
 
 // ValidateFile validates the content of a single markdown file
@@ -150,6 +159,8 @@ func (v *Validator) ValidateFile(content, filePath string) ValidationResult {
 	}
 
 	result.NumFrontMatterLines = numFMLines
+	result.Owner = frontMatter.Owner
+	result.FrontMatter = frontMatter
 
 	// Run validations
 	v.validateAll(frontMatter, fmString, filePath, &result)
@@ -157,8 +168,20 @@ func (v *Validator) ValidateFile(content, filePath string) ValidationResult {
 	return result
 }
 
+// ParseFrontMatter extracts and parses the frontmatter from the content of a
+// Markdown file. It returns nil without an error if the content has no frontmatter.
+func ParseFrontMatter(content string) (*FrontMatter, error) {
+	frontMatter, _, _, err := parseFrontMatter(content)
+	return frontMatter, err
+}
+
 // parseFrontMatter extracts and parses the frontmatter from content
 func (v *Validator) parseFrontMatter(content string) (*FrontMatter, string, int, error) {
+	return parseFrontMatter(content)
+}
+
+// parseFrontMatter returns the parsed frontmatter, its raw YAML and its number of lines
+func parseFrontMatter(content string) (*FrontMatter, string, int, error) {
 	// Find frontmatter boundaries
 	re := regexp.MustCompile(`(?m)^---\n`)
 	matches := re.FindAllStringIndex(content, -1)
@@ -208,9 +231,16 @@ func (v *Validator) validateAll(fm *FrontMatter, fmString, filePath string, resu
 	// Validate diataxis content type
 	v.validateDiataxisContentType(fm, filePath, result)
 
+	// Validate date formats
+	lines := &keyLines{fmString: fmString}
+	v.validateDateFormats(fm, lines, filePath, result)
+
 	// Validate last review date
 	v.validateLastReviewDate(fm, filePath, result)
 
+	// Validate value ranges
+	v.validateValueRanges(fm, lines, filePath, result)
+
 	// Validate runbook
 	v.validateRunbook(fm, filePath, result)
 }
@@ -370,7 +400,7 @@ func (v *Validator) validateOwner(fm *FrontMatter, filePath string, result *Vali
 // validateUserQuestions validates the user_questions field
 func (v *Validator) validateUserQuestions(fm *FrontMatter, filePath string, result *ValidationResult) {
 	if len(fm.UserQuestions) == 0 {
-		if !v.shouldSkipCheck(filePath, NoUserQuestions) && !strings.HasSuffix(filePath, "_index.md") {
+		if !v.shouldSkipCheck(filePath, NoUserQuestions) && !isSectionPage(filePath) {
 			result.Checks = append(result.Checks, CheckResult{
 				Check: NoUserQuestions,
 			})
@@ -404,11 +434,12 @@ var validDiataxisContentTypes = map[string]bool{
 
 // validateDiataxisContentType validates the diataxis_content_type field.
 // The field is required on articles but not on list pages: like user_questions,
-// the missing-field check is skipped for "_index.md" section pages. When the field
-// is present it must be one of the allowed Diátaxis values.
+// the missing-field check is skipped for "_index.md" section pages, whatever
+// their extension. When the field is present it must be one of the allowed
+// Diátaxis values.
 func (v *Validator) validateDiataxisContentType(fm *FrontMatter, filePath string, result *ValidationResult) {
 	if fm.DiataxisContentType == "" {
-		if !v.shouldSkipCheck(filePath, NoDiataxisContentType) && !strings.HasSuffix(filePath, "_index.md") {
+		if !v.shouldSkipCheck(filePath, NoDiataxisContentType) && !isSectionPage(filePath) {
 			result.Checks = append(result.Checks, CheckResult{
 				Check: NoDiataxisContentType,
 			})
@@ -426,6 +457,99 @@ func (v *Validator) validateDiataxisContentType(fm *FrontMatter, filePath string
 	}
 }
 
+// validateDateFormats flags dates not written in ISO format. The last_review_date
+// must be a plain YYYY-MM-DD date, while date may also be an ISO 8601 timestamp.
+func (v *Validator) validateDateFormats(fm *FrontMatter, lines *keyLines, filePath string, result *ValidationResult) {
+	if v.shouldSkipCheck(filePath, NonISODate) {
+		return
+	}
+
+	if fm.LastReviewDate != nil && !fm.LastReviewDate.IsISODate() {
+		result.Checks = append(result.Checks, CheckResult{
+			Check: NonISODate,
+			Value: fm.LastReviewDate.Raw,
+			Line:  lines.line("last_review_date"),
+		})
+	}
+
+	if fm.Date != nil && !fm.Date.IsISO() {
+		result.Checks = append(result.Checks, CheckResult{
+			Check: NonISODate,
+			Value: fm.Date.Raw,
+			Line:  lines.line("date"),
+		})
+	}
+}
+
+// validateValueRanges validates the values of expiration_in_days, date and weight
+func (v *Validator) validateValueRanges(fm *FrontMatter, lines *keyLines, filePath string, result *ValidationResult) {
+	if fm.ExpirationInDays != nil && !v.expiration.Contains(*fm.ExpirationInDays) {
+		if !v.shouldSkipCheck(filePath, InvalidExpirationInDays) {
+			result.Checks = append(result.Checks, CheckResult{
+				Check:   InvalidExpirationInDays,
+				Value:   *fm.ExpirationInDays,
+				Message: fmt.Sprintf("The expiration_in_days value is outside the allowed range of %d to %d", v.expiration.Min, v.expiration.Max),
+				Line:    lines.line("expiration_in_days"),
+			})
+		}
+	}
+
+	if fm.Date != nil && v.strictDates && fm.Date.IsAmbiguous() {
+		if !v.shouldSkipCheck(filePath, InvalidDate) {
+			result.Checks = append(result.Checks, CheckResult{
+				Check: InvalidDate,
+				Value: fm.Date.Raw,
+				Line:  lines.line("date"),
+			})
+		}
+	} else if fm.Date != nil && CalendarDate(fm.Date.Time).After(v.Today()) {
+		if !v.shouldSkipCheck(filePath, FutureDate) {
+			result.Checks = append(result.Checks, CheckResult{
+				Check: FutureDate,
+				Value: fm.Date.Raw,
+				Line:  lines.line("date"),
+			})
+		}
+	}
+
+	if fm.Weight != nil && !v.weight.Contains(*fm.Weight) {
+		if !v.shouldSkipCheck(filePath, InvalidWeight) {
+			result.Checks = append(result.Checks, CheckResult{
+				Check:   InvalidWeight,
+				Value:   *fm.Weight,
+				Message: fmt.Sprintf("The weight value is outside the allowed range of %d to %d", v.weight.Min, v.weight.Max),
+				Line:    lines.line("weight"),
+			})
+		}
+	}
+}
+
+// keyLines looks up the line numbers of top-level frontmatter keys within the
+// file, assuming the frontmatter starts on the first line. The frontmatter is
+// parsed once, on the first lookup.
+type keyLines struct {
+	fmString string
+	lines    map[string]int
+}
+
+// line returns the line number of a key, or 0 if the key is not found
+func (k *keyLines) line(key string) int {
+	if k.lines == nil {
+		k.lines = make(map[string]int)
+		var doc yaml.Node
+		if err := yaml.Unmarshal([]byte(k.fmString), &doc); err == nil && len(doc.Content) > 0 {
+			mapping := doc.Content[0]
+			for i := 0; i+1 < len(mapping.Content); i += 2 {
+				if _, seen := k.lines[mapping.Content[i].Value]; !seen {
+					// The opening "---" occupies the first line of the file
+					k.lines[mapping.Content[i].Value] = mapping.Content[i].Line + 1
+				}
+			}
+		}
+	}
+	return k.lines[key]
+}
+
 // validateLastReviewDate validates the last_review_date field
 func (v *Validator) validateLastReviewDate(fm *FrontMatter, filePath string, result *ValidationResult) {
 	if fm.LastReviewDate == nil {
@@ -434,28 +558,37 @@ func (v *Validator) validateLastReviewDate(fm *FrontMatter, filePath string, res
 				Check: NoLastReviewDate,
 			})
 		}
+	} else if v.strictDates && fm.LastReviewDate.IsAmbiguous() {
+		result.Checks = append(result.Checks, CheckResult{
+			Check: InvalidLastReviewDate,
+			Value: fm.LastReviewDate.Raw,
+			Title: fm.Title,
+			Owner: fm.Owner,
+		})
 	} else {
-		today := time.Now()
+		// Compare calendar dates, so the result doesn't depend on the time of day
+		today := v.Today()
+		lastReview := CalendarDate(fm.LastReviewDate.Time)
 
 		// Check if date is in the future
-		if fm.LastReviewDate.Time.After(today) {
+		if lastReview.After(today) {
 			result.Checks = append(result.Checks, CheckResult{
 				Check: InvalidLastReviewDate,
-				Value: fm.LastReviewDate.Time.Format("2006-01-02"),
+				Value: lastReview.Format("2006-01-02"),
 				Title: fm.Title,
 				Owner: fm.Owner,
 			})
 		} else if !v.shouldSkipCheck(filePath, ReviewTooLongAgo) {
 			// Check if review is too long ago
-			expiration := 365
+			expiration := DefaultExpirationInDays
 			if fm.ExpirationInDays != nil {
 				expiration = *fm.ExpirationInDays
 			}
 
-			if today.Sub(fm.LastReviewDate.Time) > time.Duration(expiration)*24*time.Hour {
+			if today.After(lastReview.AddDate(0, 0, expiration)) {
 				result.Checks = append(result.Checks, CheckResult{
 					Check: ReviewTooLongAgo,
-					Value: fm.LastReviewDate.Time.Format("2006-01-02"),
+					Value: lastReview.Format("2006-01-02"),
 					Title: fm.Title,
 					Owner: fm.Owner,
 				})
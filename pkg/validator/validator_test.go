@@ -3,8 +3,10 @@ package validator
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestValidateFile_NoFrontMatter(t *testing.T) {
@@ -698,9 +700,12 @@ user_questions:
 		},
 	}
 
+	// Evaluate as of a fixed date, so the results don't depend on when tests run
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			v := New()
+			v := New(WithClock(FixedClock(now)), WithLocation(time.UTC))
 			result := v.ValidateFile(tt.content, "test.md")
 
 			checkIDs := getCheckIDs(result.Checks)
@@ -825,3 +830,262 @@ func TestValidateFile_DiataxisContentTypeOptInByDefault(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateFile_LastReviewDateAsOfClock(t *testing.T) {
+	tokyo := time.FixedZone("Asia/Tokyo", 9*60*60)
+
+	tests := []struct {
+		name           string
+		lastReviewDate string
+		expiration     string
+		now            time.Time
+		location       *time.Location
+		expectChecks   []string
+	}{
+		{
+			name:           "due date is not overdue yet",
+			lastReviewDate: "2025-03-01",
+			now:            time.Date(2026, 3, 1, 23, 59, 0, 0, time.UTC),
+			location:       time.UTC,
+		},
+		{
+			name:           "day after due date is overdue",
+			lastReviewDate: "2025-03-01",
+			now:            time.Date(2026, 3, 2, 0, 1, 0, 0, time.UTC),
+			location:       time.UTC,
+			expectChecks:   []string{ReviewTooLongAgo},
+		},
+		{
+			name:           "custom expiration",
+			lastReviewDate: "2026-01-01",
+			expiration:     "expiration_in_days: 30\n",
+			now:            time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			location:       time.UTC,
+			expectChecks:   []string{ReviewTooLongAgo},
+		},
+		{
+			name:           "review today is not in the future",
+			lastReviewDate: "2026-03-01",
+			now:            time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			location:       time.UTC,
+		},
+		{
+			name:           "review tomorrow is in the future",
+			lastReviewDate: "2026-03-02",
+			now:            time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC),
+			location:       time.UTC,
+			expectChecks:   []string{InvalidLastReviewDate},
+		},
+		{
+			name:           "configured timezone is already on the next day",
+			lastReviewDate: "2026-03-02",
+			now:            time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC),
+			location:       tokyo,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := &mockConfigManager{
+				defaultChecks: []string{ReviewTooLongAgo, InvalidLastReviewDate},
+			}
+			v := NewWithConfig(cm, WithClock(FixedClock(tt.now)), WithLocation(tt.location))
+			content := "---\ntitle: Test page\nlast_review_date: " + tt.lastReviewDate + "\n" + tt.expiration + "---\n"
+
+			got := getCheckIDs(v.ValidateFile(content, "test.md").Checks)
+			if len(got) == 0 && len(tt.expectChecks) == 0 {
+				return
+			}
+			if strings.Join(got, ",") != strings.Join(tt.expectChecks, ",") {
+				t.Errorf("Expected checks %v, got %v", tt.expectChecks, got)
+			}
+		})
+	}
+}
+
+func TestValidateFile_NonISODate(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		frontMatter  string
+		strictDates  bool
+		expectChecks []string
+		expectValues []interface{}
+		expectLines  []int
+	}{
+		{
+			name:        "ISO dates",
+			frontMatter: "last_review_date: 2025-06-01\ndate: 2021-03-12T14:00:00\n",
+		},
+		{
+			name:         "last_review_date must not be a timestamp",
+			frontMatter:  "last_review_date: \"2025-06-01T00:00:00Z\"\n",
+			expectChecks: []string{NonISODate},
+			expectValues: []interface{}{"2025-06-01T00:00:00Z"},
+			expectLines:  []int{3},
+		},
+		{
+			name:         "slash formats are flagged with their line",
+			frontMatter:  "date: 25/03/2021\nlast_review_date: 06/01/2025\n",
+			expectChecks: []string{NonISODate, NonISODate},
+			expectValues: []interface{}{"06/01/2025", "25/03/2021"},
+			expectLines:  []int{4, 3},
+		},
+		{
+			name:         "strict mode rejects ambiguous last_review_date",
+			frontMatter:  "last_review_date: 06/01/2025\n",
+			strictDates:  true,
+			expectChecks: []string{NonISODate, InvalidLastReviewDate},
+			expectValues: []interface{}{"06/01/2025", "06/01/2025"},
+			expectLines:  []int{3, 0},
+		},
+		{
+			name:         "strict mode rejects ambiguous date",
+			frontMatter:  "date: 12/01/2026\n",
+			strictDates:  true,
+			expectChecks: []string{NonISODate, InvalidDate},
+			expectValues: []interface{}{"12/01/2026", "12/01/2026"},
+			expectLines:  []int{3, 3},
+		},
+		{
+			name:         "ambiguous date is read as MM/DD without strict mode",
+			frontMatter:  "date: 12/01/2026\n",
+			expectChecks: []string{NonISODate, FutureDate},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := &mockConfigManager{
+				defaultChecks: []string{NonISODate, InvalidLastReviewDate, ReviewTooLongAgo, InvalidDate, FutureDate},
+			}
+			v := NewWithConfig(cm, WithClock(FixedClock(now)), WithStrictDates(tt.strictDates))
+			result := v.ValidateFile("---\ntitle: Test page\n"+tt.frontMatter+"---\n", "test.md")
+
+			var values []interface{}
+			var lines []int
+			for _, check := range result.Checks {
+				values = append(values, check.Value)
+				lines = append(lines, check.Line)
+			}
+
+			if got := getCheckIDs(result.Checks); strings.Join(got, ",") != strings.Join(tt.expectChecks, ",") {
+				t.Errorf("Expected checks %v, got %v", tt.expectChecks, got)
+			}
+			if len(tt.expectValues) > 0 && !reflect.DeepEqual(values, tt.expectValues) {
+				t.Errorf("Expected values %v, got %v", tt.expectValues, values)
+			}
+			if len(tt.expectLines) > 0 && !reflect.DeepEqual(lines, tt.expectLines) {
+				t.Errorf("Expected lines %v, got %v", tt.expectLines, lines)
+			}
+		})
+	}
+}
+
+func TestValidateFile_ValueRanges(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		frontMatter    string
+		opts           []Option
+		expectChecks   []string
+		expectValues   []interface{}
+		expectMessages []string
+		expectLines    []int
+	}{
+		{
+			name:        "values within default bounds",
+			frontMatter: "date: 2026-03-01\nexpiration_in_days: 180\nweight: 100\n",
+		},
+		{
+			name:           "zero expiration_in_days",
+			frontMatter:    "expiration_in_days: 0\n",
+			expectChecks:   []string{InvalidExpirationInDays},
+			expectValues:   []interface{}{0},
+			expectMessages: []string{"The expiration_in_days value is outside the allowed range of 1 to 1095"},
+			expectLines:    []int{3},
+		},
+		{
+			name:           "negative expiration_in_days",
+			frontMatter:    "weight: 10\nexpiration_in_days: -30\n",
+			expectChecks:   []string{InvalidExpirationInDays},
+			expectValues:   []interface{}{-30},
+			expectMessages: []string{"The expiration_in_days value is outside the allowed range of 1 to 1095"},
+			expectLines:    []int{4},
+		},
+		{
+			name:           "configured expiration bounds",
+			frontMatter:    "expiration_in_days: 400\n",
+			opts:           []Option{WithExpirationBounds(Bounds{Min: 30, Max: 365})},
+			expectChecks:   []string{InvalidExpirationInDays},
+			expectValues:   []interface{}{400},
+			expectMessages: []string{"The expiration_in_days value is outside the allowed range of 30 to 365"},
+			expectLines:    []int{3},
+		},
+		{
+			name:         "future date",
+			frontMatter:  "date: 2026-03-02\n",
+			expectChecks: []string{FutureDate},
+			expectValues: []interface{}{"2026-03-02"},
+			expectLines:  []int{3},
+		},
+		{
+			name:        "timestamp later today is not in the future",
+			frontMatter: "date: 2026-03-01T23:00:00\n",
+		},
+		{
+			name:           "absurd weight",
+			frontMatter:    "weight: 2147483647\n",
+			expectChecks:   []string{InvalidWeight},
+			expectValues:   []interface{}{2147483647},
+			expectMessages: []string{"The weight value is outside the allowed range of -100000 to 100000"},
+			expectLines:    []int{3},
+		},
+		{
+			name:           "configured weight bounds",
+			frontMatter:    "weight: -1\n",
+			opts:           []Option{WithWeightBounds(Bounds{Min: 0, Max: 1000})},
+			expectChecks:   []string{InvalidWeight},
+			expectValues:   []interface{}{-1},
+			expectMessages: []string{"The weight value is outside the allowed range of 0 to 1000"},
+			expectLines:    []int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := &mockConfigManager{
+				defaultChecks: []string{InvalidExpirationInDays, FutureDate, InvalidWeight},
+			}
+			opts := append([]Option{WithClock(FixedClock(now)), WithLocation(time.UTC)}, tt.opts...)
+			v := NewWithConfig(cm, opts...)
+			result := v.ValidateFile("---\ntitle: Test page\n"+tt.frontMatter+"---\n", "test.md")
+
+			var values []interface{}
+			var messages []string
+			var lines []int
+			for _, check := range result.Checks {
+				values = append(values, check.Value)
+				if check.Message != "" {
+					messages = append(messages, check.Message)
+				}
+				lines = append(lines, check.Line)
+			}
+
+			if got := getCheckIDs(result.Checks); strings.Join(got, ",") != strings.Join(tt.expectChecks, ",") {
+				t.Errorf("Expected checks %v, got %v", tt.expectChecks, got)
+			}
+			if !reflect.DeepEqual(values, tt.expectValues) {
+				t.Errorf("Expected values %v, got %v", tt.expectValues, values)
+			}
+			if !reflect.DeepEqual(messages, tt.expectMessages) {
+				t.Errorf("Expected messages %v, got %v", tt.expectMessages, messages)
+			}
+			if !reflect.DeepEqual(lines, tt.expectLines) {
+				t.Errorf("Expected lines %v, got %v", tt.expectLines, lines)
+			}
+		})
+	}
+}